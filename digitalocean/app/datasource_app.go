@@ -78,6 +78,16 @@ func DataSourceDigitalOceanApp() *schema.Resource {
 				Computed:    true,
 				Description: "The uniform resource identifier for the app",
 			},
+			"price_monthly": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The monthly price of the services and workers of the app in USD",
+			},
+			"price_hourly": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The hourly price of the services and workers of the app in USD",
+			},
 			"updated_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -103,6 +103,18 @@ func ResourceDigitalOceanApp() *schema.Resource {
 				Description: "The uniform resource identifier for the app",
 			},
 
+			"price_monthly": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The monthly price of the services and workers of the app in USD",
+			},
+
+			"price_hourly": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The hourly price of the services and workers of the app in USD",
+			},
+
 			"updated_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -119,6 +131,8 @@ func ResourceDigitalOceanApp() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 		},
+
+		CustomizeDiff: customizeDiffAppPrice,
 	}
 }
 
@@ -200,6 +214,13 @@ func resourceDigitalOceanAppRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("urn", app.URN())
 	d.Set("project_id", app.ProjectID)
 
+	price, err := meta.(*config.CombinedConfig).PriceCatalog().AppSpec(ctx, app.Spec)
+	if err != nil {
+		log.Printf("[WARN] Unable to determine the price of app (%s): %s", app.ID, err)
+	}
+	d.Set("price_monthly", price.Monthly)
+	d.Set("price_hourly", price.Hourly)
+
 	if app.DedicatedIps != nil {
 		d.Set("dedicated_ips", appDedicatedIps(d, app))
 	}
@@ -222,6 +243,36 @@ func resourceDigitalOceanAppRead(ctx context.Context, d *schema.ResourceData, me
 	return nil
 }
 
+// customizeDiffAppPrice shows the price of the app in the plan when its spec
+// changes. When the price can not be determined from the configuration, e.g.
+// when a component relies on the default instance size, it is left to be
+// computed after the app is deployed.
+func customizeDiffAppPrice(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("spec") {
+		return nil
+	}
+
+	if !d.NewValueKnown("spec") {
+		d.SetNewComputed("price_monthly")
+		d.SetNewComputed("price_hourly")
+		return nil
+	}
+
+	spec := expandAppSpec(d.Get("spec").([]interface{}))
+	price, err := meta.(*config.CombinedConfig).PriceCatalog().AppSpec(ctx, spec)
+	if err != nil {
+		log.Printf("[WARN] Unable to determine the price of app: %s", err)
+		d.SetNewComputed("price_monthly")
+		d.SetNewComputed("price_hourly")
+		return nil
+	}
+
+	d.SetNew("price_monthly", price.Monthly)
+	d.SetNew("price_hourly", price.Hourly)
+
+	return nil
+}
+
 func appDedicatedIps(d *schema.ResourceData, app *godo.App) []interface{} {
 	remote := make([]interface{}, 0, len(app.DedicatedIps))
 	for _, change := range app.DedicatedIps {
@@ -72,6 +72,8 @@ func TestAccDigitalOceanApp_Basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("digitalocean_app.foobar", "urn"),
 					resource.TestCheckResourceAttrSet("digitalocean_app.foobar", "updated_at"),
 					resource.TestCheckResourceAttrSet("digitalocean_app.foobar", "created_at"),
+					resource.TestCheckResourceAttrSet("digitalocean_app.foobar", "price_monthly"),
+					resource.TestCheckResourceAttrSet("digitalocean_app.foobar", "price_hourly"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_app.foobar", "spec.0.alert.0.rule", "DEPLOYMENT_FAILED"),
 					resource.TestCheckResourceAttr(
@@ -0,0 +1,111 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanBillingHistory() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        billingHistorySchema(),
+		ResultAttributeName: "billing_history",
+		GetRecords:          getDigitalOceanBillingHistory,
+		FlattenRecord:       flattenDigitalOceanBillingHistoryEntry,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func billingHistorySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"description": {
+			Type:        schema.TypeString,
+			Description: "description of the billing history entry",
+		},
+		"amount": {
+			Type:        schema.TypeString,
+			Description: "amount of the billing history entry in USD",
+		},
+		"invoice_id": {
+			Type:        schema.TypeString,
+			Description: "ID of the invoice associated with the entry, if any",
+		},
+		"invoice_uuid": {
+			Type:        schema.TypeString,
+			Description: "UUID of the invoice associated with the entry, if any",
+		},
+		"date": {
+			Type:        schema.TypeString,
+			Description: "the date of the billing history entry",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "type of the billing history entry, e.g. Invoice or Payment",
+		},
+	}
+}
+
+func getDigitalOceanBillingHistory(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var entryList []interface{}
+
+	for {
+		history, resp, err := client.BillingHistory.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving billing history: %s", err)
+		}
+
+		for _, entry := range history.BillingHistory {
+			entryList = append(entryList, entry)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving billing history: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return entryList, nil
+}
+
+func flattenDigitalOceanBillingHistoryEntry(rawEntry, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	entry, ok := rawEntry.(godo.BillingHistoryEntry)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.BillingHistoryEntry")
+	}
+
+	flattenedEntry := map[string]interface{}{
+		"description":  entry.Description,
+		"amount":       entry.Amount,
+		"invoice_id":   "",
+		"invoice_uuid": "",
+		"date":         entry.Date.UTC().String(),
+		"type":         entry.Type,
+	}
+
+	if entry.InvoiceID != nil {
+		flattenedEntry["invoice_id"] = *entry.InvoiceID
+	}
+	if entry.InvoiceUUID != nil {
+		flattenedEntry["invoice_uuid"] = *entry.InvoiceUUID
+	}
+
+	return flattenedEntry, nil
+}
@@ -0,0 +1,387 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanInvoice() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanInvoiceRead,
+		Schema: map[string]*schema.Schema{
+			"invoice_uuid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "UUID of the invoice",
+			},
+			"csv_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Local path the CSV of the invoice is written to",
+			},
+			"pdf_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "Local path the PDF of the invoice is written to",
+			},
+			"billing_period": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Billing period of the invoice, e.g. 2020-01",
+			},
+			"amount": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Total amount of the invoice in USD",
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the user the invoice is billed to",
+			},
+			"user_company": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Company of the user the invoice is billed to",
+			},
+			"user_email": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Email address of the user the invoice is billed to",
+			},
+			"product_charges":         invoiceSummaryBreakdownSchema("Charges for products used during the billing period"),
+			"overages":                invoiceSummaryBreakdownSchema("Charges for usage beyond the included allowances"),
+			"taxes":                   invoiceSummaryBreakdownSchema("Taxes applied to the invoice"),
+			"credits_and_adjustments": invoiceSummaryBreakdownSchema("Credits and adjustments applied to the invoice"),
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Line items of the invoice",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"amount": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"duration": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"duration_unit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"project_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"project_summaries": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Line items of the invoice summarized per project",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"amount": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"item_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func invoiceSummaryBreakdownSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"amount": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"items": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"amount": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"count": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanInvoiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	invoiceUUID := d.Get("invoice_uuid").(string)
+
+	summary, _, err := client.Invoices.GetSummary(context.Background(), invoiceUUID)
+	if err != nil {
+		return diag.Errorf("Error retrieving invoice summary: %s", err)
+	}
+
+	items, err := listInvoiceItems(client, invoiceUUID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectSummaries, err := FlattenInvoiceProjectSummaries(items)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v, ok := d.GetOk("csv_path"); ok {
+		csv, _, err := client.Invoices.GetCSV(context.Background(), invoiceUUID)
+		if err != nil {
+			return diag.Errorf("Error retrieving invoice CSV: %s", err)
+		}
+		if err := writeInvoiceFile(v.(string), csv); err != nil {
+			return diag.Errorf("Error writing invoice CSV: %s", err)
+		}
+	}
+
+	if v, ok := d.GetOk("pdf_path"); ok {
+		pdf, _, err := client.Invoices.GetPDF(context.Background(), invoiceUUID)
+		if err != nil {
+			return diag.Errorf("Error retrieving invoice PDF: %s", err)
+		}
+		if err := writeInvoiceFile(v.(string), pdf); err != nil {
+			return diag.Errorf("Error writing invoice PDF: %s", err)
+		}
+	}
+
+	d.SetId(invoiceUUID)
+	d.Set("billing_period", summary.BillingPeriod)
+	d.Set("amount", summary.Amount)
+	d.Set("user_name", summary.UserName)
+	d.Set("user_company", summary.UserCompany)
+	d.Set("user_email", summary.UserEmail)
+
+	if err := d.Set("product_charges", flattenInvoiceSummaryBreakdown(summary.ProductCharges)); err != nil {
+		return diag.Errorf("Error setting product_charges: %s", err)
+	}
+	if err := d.Set("overages", flattenInvoiceSummaryBreakdown(summary.Overages)); err != nil {
+		return diag.Errorf("Error setting overages: %s", err)
+	}
+	if err := d.Set("taxes", flattenInvoiceSummaryBreakdown(summary.Taxes)); err != nil {
+		return diag.Errorf("Error setting taxes: %s", err)
+	}
+	if err := d.Set("credits_and_adjustments", flattenInvoiceSummaryBreakdown(summary.CreditsAndAdjustments)); err != nil {
+		return diag.Errorf("Error setting credits_and_adjustments: %s", err)
+	}
+	if err := d.Set("items", flattenInvoiceItems(items)); err != nil {
+		return diag.Errorf("Error setting items: %s", err)
+	}
+	if err := d.Set("project_summaries", projectSummaries); err != nil {
+		return diag.Errorf("Error setting project_summaries: %s", err)
+	}
+
+	return nil
+}
+
+func listInvoiceItems(client *godo.Client, invoiceUUID string) ([]godo.InvoiceItem, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var itemList []godo.InvoiceItem
+
+	for {
+		invoice, resp, err := client.Invoices.Get(context.Background(), invoiceUUID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving invoice items: %s", err)
+		}
+
+		itemList = append(itemList, invoice.InvoiceItems...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving invoice items: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return itemList, nil
+}
+
+func writeInvoiceFile(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0644)
+}
+
+func flattenInvoiceSummaryBreakdown(breakdown godo.InvoiceSummaryBreakdown) []interface{} {
+	items := make([]interface{}, 0, len(breakdown.Items))
+	for _, item := range breakdown.Items {
+		items = append(items, map[string]interface{}{
+			"name":   item.Name,
+			"amount": item.Amount,
+			"count":  item.Count,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":   breakdown.Name,
+			"amount": breakdown.Amount,
+			"items":  items,
+		},
+	}
+}
+
+func flattenInvoiceItems(items []godo.InvoiceItem) []interface{} {
+	flattenedItems := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		flattenedItems = append(flattenedItems, map[string]interface{}{
+			"product":           item.Product,
+			"resource_id":       item.ResourceID,
+			"resource_uuid":     item.ResourceUUID,
+			"group_description": item.GroupDescription,
+			"description":       item.Description,
+			"amount":            item.Amount,
+			"duration":          item.Duration,
+			"duration_unit":     item.DurationUnit,
+			"start_time":        item.StartTime.UTC().String(),
+			"end_time":          item.EndTime.UTC().String(),
+			"project_name":      item.ProjectName,
+			"category":          item.Category,
+		})
+	}
+
+	return flattenedItems
+}
+
+// FlattenInvoiceProjectSummaries sums the line items of each project. Amounts
+// are summed in cents so that the totals are not affected by rounding errors.
+func FlattenInvoiceProjectSummaries(items []godo.InvoiceItem) ([]interface{}, error) {
+	cents := make(map[string]int64)
+	counts := make(map[string]int)
+	for _, item := range items {
+		counts[item.ProjectName]++
+		if item.Amount == "" {
+			continue
+		}
+
+		amount, err := strconv.ParseFloat(item.Amount, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing amount of invoice item %q: %s", item.Description, err)
+		}
+
+		cents[item.ProjectName] += int64(math.Round(amount * 100))
+	}
+
+	projects := make([]string, 0, len(counts))
+	for project := range counts {
+		projects = append(projects, project)
+	}
+	sort.Strings(projects)
+
+	summaries := make([]interface{}, 0, len(projects))
+	for _, project := range projects {
+		summaries = append(summaries, map[string]interface{}{
+			"project_name": project,
+			"amount":       FormatCents(cents[project]),
+			"item_count":   counts[project],
+		})
+	}
+
+	return summaries, nil
+}
+
+// FormatCents formats an amount in cents as dollars, e.g. -1.50.
+func FormatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
@@ -0,0 +1,176 @@
+package billing_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/billing"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceDigitalOceanInvoice_Basic(t *testing.T) {
+	dir := t.TempDir()
+	csvPath := filepath.Join(dir, "invoice.csv")
+	pdfPath := filepath.Join(dir, "invoice.pdf")
+
+	config := fmt.Sprintf(`
+data "digitalocean_invoices" "foobar" {
+  filter {
+    key    = "preview"
+    values = ["false"]
+  }
+
+  sort {
+    key       = "invoice_period"
+    direction = "desc"
+  }
+}
+
+data "digitalocean_invoice" "foobar" {
+  invoice_uuid = data.digitalocean_invoices.foobar.invoices[0].invoice_uuid
+  csv_path     = "%s"
+  pdf_path     = "%s"
+}
+`, csvPath, pdfPath)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_invoices.foobar", "invoices.0.invoice_uuid"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_invoices.foobar", "invoices.0.preview", "false"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_invoice.foobar", "billing_period",
+						"data.digitalocean_invoices.foobar", "invoices.0.invoice_period"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_invoice.foobar", "amount",
+						"data.digitalocean_invoices.foobar", "invoices.0.amount"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_invoice.foobar", "product_charges.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_invoice.foobar", "items.#"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_invoice.foobar", "project_summaries.#"),
+					testAccCheckInvoiceFileWritten(csvPath),
+					testAccCheckInvoiceFileWritten(pdfPath),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInvoiceFileWritten(path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("Invoice file not written: %s", err)
+		}
+
+		if info.Size() == 0 {
+			return fmt.Errorf("Invoice file %s is empty", path)
+		}
+
+		return nil
+	}
+}
+
+func TestFormatCents(t *testing.T) {
+	cases := map[int64]string{
+		0:       "0.00",
+		5:       "0.05",
+		150:     "1.50",
+		123456:  "1234.56",
+		-5:      "-0.05",
+		-150:    "-1.50",
+		-123456: "-1234.56",
+	}
+
+	for cents, expected := range cases {
+		if got := billing.FormatCents(cents); got != expected {
+			t.Errorf("FormatCents(%d) = %v, expected %v", cents, got, expected)
+		}
+	}
+}
+
+func TestFlattenInvoiceProjectSummaries(t *testing.T) {
+	cases := []struct {
+		name     string
+		items    []godo.InvoiceItem
+		expected []interface{}
+	}{
+		{
+			name:     "no items",
+			items:    nil,
+			expected: []interface{}{},
+		},
+		{
+			name: "amounts are summed per project",
+			items: []godo.InvoiceItem{
+				{ProjectName: "web", Amount: "12.10"},
+				{ProjectName: "db", Amount: "15.00"},
+				{ProjectName: "web", Amount: "0.20"},
+				{ProjectName: "web", Amount: "0.10"},
+			},
+			expected: []interface{}{
+				map[string]interface{}{"project_name": "db", "amount": "15.00", "item_count": 1},
+				map[string]interface{}{"project_name": "web", "amount": "12.40", "item_count": 3},
+			},
+		},
+		{
+			name: "credits are subtracted",
+			items: []godo.InvoiceItem{
+				{ProjectName: "web", Amount: "5.00"},
+				{ProjectName: "web", Amount: "-7.25"},
+				{ProjectName: "db", Amount: "-0.01"},
+			},
+			expected: []interface{}{
+				map[string]interface{}{"project_name": "db", "amount": "-0.01", "item_count": 1},
+				map[string]interface{}{"project_name": "web", "amount": "-2.25", "item_count": 2},
+			},
+		},
+		{
+			name: "empty amounts are counted but not summed",
+			items: []godo.InvoiceItem{
+				{ProjectName: "web", Amount: ""},
+				{ProjectName: "web", Amount: "1.00"},
+				{ProjectName: "", Amount: ""},
+			},
+			expected: []interface{}{
+				map[string]interface{}{"project_name": "", "amount": "0.00", "item_count": 1},
+				map[string]interface{}{"project_name": "web", "amount": "1.00", "item_count": 2},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			summaries, err := billing.FlattenInvoiceProjectSummaries(tc.items)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(summaries, tc.expected) {
+				t.Errorf("summaries = %v, expected %v", summaries, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFlattenInvoiceProjectSummaries_InvalidAmount(t *testing.T) {
+	_, err := billing.FlattenInvoiceProjectSummaries([]godo.InvoiceItem{
+		{ProjectName: "web", Description: "Droplet", Amount: "n/a"},
+	})
+	if err == nil {
+		t.Errorf("expected an error parsing the amount")
+	}
+}
@@ -0,0 +1,111 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// invoiceListItem adds whether the invoice is the preview of the current
+// billing period, which the API returns separately from the other invoices.
+type invoiceListItem struct {
+	godo.InvoiceListItem
+	preview bool
+}
+
+func DataSourceDigitalOceanInvoices() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        invoiceListItemSchema(),
+		ResultAttributeName: "invoices",
+		GetRecords:          getDigitalOceanInvoices,
+		FlattenRecord:       flattenDigitalOceanInvoiceListItem,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func invoiceListItemSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"invoice_uuid": {
+			Type:        schema.TypeString,
+			Description: "UUID of the invoice",
+		},
+		"amount": {
+			Type:        schema.TypeString,
+			Description: "total amount of the invoice in USD",
+		},
+		"invoice_period": {
+			Type:        schema.TypeString,
+			Description: "billing period of the invoice, e.g. 2020-01",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "the date the invoice was last updated",
+		},
+		"preview": {
+			Type:        schema.TypeBool,
+			Description: "whether the invoice is the preview of the current billing period",
+		},
+	}
+}
+
+func getDigitalOceanInvoices(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var invoiceList []interface{}
+
+	for {
+		invoices, resp, err := client.Invoices.List(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving invoices: %s", err)
+		}
+
+		// The preview is returned on every page, so only add it once.
+		if opts.Page == 1 && invoices.InvoicePreview.InvoiceUUID != "" {
+			invoiceList = append(invoiceList, invoiceListItem{InvoiceListItem: invoices.InvoicePreview, preview: true})
+		}
+
+		for _, invoice := range invoices.Invoices {
+			invoiceList = append(invoiceList, invoiceListItem{InvoiceListItem: invoice})
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving invoices: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return invoiceList, nil
+}
+
+func flattenDigitalOceanInvoiceListItem(rawInvoice, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	invoice, ok := rawInvoice.(invoiceListItem)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to invoiceListItem")
+	}
+
+	flattenedInvoice := map[string]interface{}{
+		"invoice_uuid":   invoice.InvoiceUUID,
+		"amount":         invoice.Amount,
+		"invoice_period": invoice.InvoicePeriod,
+		"updated_at":     invoice.UpdatedAt.UTC().String(),
+		"preview":        invoice.preview,
+	}
+
+	return flattenedInvoice, nil
+}
@@ -0,0 +1,109 @@
+package byoip
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanBYOIPPrefixResources() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        byoipPrefixResourceSchema(),
+		ResultAttributeName: "addresses",
+		GetRecords:          getDigitalOceanBYOIPPrefixResources,
+		FlattenRecord:       flattenDigitalOceanBYOIPPrefixResource,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"byoip_prefix_uuid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func byoipPrefixResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeInt,
+			Description: "id of the allocation",
+		},
+		"ip_address": {
+			Type:        schema.TypeString,
+			Description: "IP address allocated from the prefix",
+		},
+		"resource": {
+			Type:        schema.TypeString,
+			Description: "URN of the resource the IP address is assigned to",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "region of the IP address",
+		},
+		"assigned_at": {
+			Type:        schema.TypeString,
+			Description: "the date the IP address was assigned",
+		},
+	}
+}
+
+func getDigitalOceanBYOIPPrefixResources(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	uuid := extra["byoip_prefix_uuid"].(string)
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var resourceList []interface{}
+
+	for {
+		resources, resp, err := client.BYOIPPrefixes.GetResources(context.Background(), uuid, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving resources of BYOIP prefix (%s): %s", uuid, err)
+		}
+
+		for _, resource := range resources {
+			resourceList = append(resourceList, resource)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving resources of BYOIP prefix (%s): %s", uuid, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return resourceList, nil
+}
+
+func flattenDigitalOceanBYOIPPrefixResource(rawResource, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	resource, ok := rawResource.(godo.BYOIPPrefixResource)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.BYOIPPrefixResource")
+	}
+
+	flattenedResource := map[string]interface{}{
+		"id":          int(resource.ID),
+		"ip_address":  resource.BYOIP,
+		"resource":    resource.Resource,
+		"region":      resource.Region,
+		"assigned_at": resource.AssignedAt.UTC().String(),
+	}
+
+	return flattenedResource, nil
+}
@@ -0,0 +1,39 @@
+package byoip_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanBYOIPPrefixResources_Basic(t *testing.T) {
+	prefix, signature := testAccBYOIPPrefixFromEnv(t)
+
+	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanBYOIPPrefixConfig_basic, prefix, signature)
+	dataSourceConfig := `
+data "digitalocean_byoip_prefix_resources" "foobar" {
+  byoip_prefix_uuid = digitalocean_byoip_prefix.foobar.uuid
+}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanBYOIPPrefixDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.digitalocean_byoip_prefix_resources.foobar", "byoip_prefix_uuid",
+						"digitalocean_byoip_prefix.foobar", "uuid"),
+					// No reserved IPs have been allocated from the new prefix yet.
+					resource.TestCheckResourceAttr("data.digitalocean_byoip_prefix_resources.foobar", "addresses.#", "0"),
+				),
+			},
+		},
+	})
+}
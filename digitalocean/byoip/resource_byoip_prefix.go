@@ -0,0 +1,198 @@
+package byoip
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	byoipPrefixStatusActive = "active"
+	byoipPrefixStatusFailed = "failed"
+
+	// byoipPrefixStatusInProgress stands in for any of the statuses a prefix
+	// passes through while it is being verified and provisioned.
+	byoipPrefixStatusInProgress = "in-progress"
+)
+
+func ResourceDigitalOceanBYOIPPrefix() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanBYOIPPrefixCreate,
+		ReadContext:   resourceDigitalOceanBYOIPPrefixRead,
+		DeleteContext: resourceDigitalOceanBYOIPPrefixDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+				Description:  "The IP prefix to bring to DigitalOcean, in CIDR notation",
+			},
+			"signature": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The signature proving ownership of the prefix",
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(val interface{}) string {
+					// DO API V2 region slug is always lowercase
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The region the prefix is announced in",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UUID of the prefix",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the prefix",
+			},
+			"failure_reason": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The reason the prefix could not be verified or provisioned",
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Hour),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceDigitalOceanBYOIPPrefixCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &godo.BYOIPPrefixCreateReq{
+		Prefix:    d.Get("prefix").(string),
+		Signature: d.Get("signature").(string),
+		Region:    d.Get("region").(string),
+	}
+
+	log.Printf("[DEBUG] BYOIP prefix create: %s in %s", opts.Prefix, opts.Region)
+	prefix, _, err := client.BYOIPPrefixes.Create(context.Background(), opts)
+	if err != nil {
+		return diag.Errorf("Error creating BYOIP prefix: %s", err)
+	}
+
+	d.SetId(prefix.UUID)
+	log.Printf("[INFO] BYOIP prefix created, ID: %s", d.Id())
+
+	// Verifying ownership of the prefix and provisioning it can take hours.
+	stateConf := &retry.StateChangeConf{
+		Delay:      30 * time.Second,
+		Pending:    []string{byoipPrefixStatusInProgress},
+		Target:     []string{byoipPrefixStatusActive},
+		Refresh:    byoipPrefixStateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 30 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for BYOIP prefix (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceDigitalOceanBYOIPPrefixRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanBYOIPPrefixRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	prefix, resp, err := client.BYOIPPrefixes.Get(context.Background(), d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] BYOIP prefix (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving BYOIP prefix: %s", err)
+	}
+
+	d.Set("prefix", prefix.Prefix)
+	d.Set("region", prefix.Region)
+	d.Set("uuid", prefix.UUID)
+	d.Set("status", prefix.Status)
+	d.Set("failure_reason", prefix.FailureReason)
+
+	return nil
+}
+
+func resourceDigitalOceanBYOIPPrefixDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	log.Printf("[INFO] Deleting BYOIP prefix: %s", d.Id())
+	resp, err := client.BYOIPPrefixes.Delete(context.Background(), d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error deleting BYOIP prefix: %s", err)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Delay:      10 * time.Second,
+		Pending:    []string{byoipPrefixStatusInProgress, byoipPrefixStatusActive},
+		Target:     []string{http.StatusText(http.StatusNotFound)},
+		Refresh:    byoipPrefixStateRefreshFunc(client, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for BYOIP prefix (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// byoipPrefixStateRefreshFunc reports `active` once the prefix is ready and
+// `in-progress` while it is being verified, provisioned or deprovisioned. A
+// prefix that failed verification or provisioning results in an error.
+func byoipPrefixStateRefreshFunc(client *godo.Client, uuid string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		prefix, resp, err := client.BYOIPPrefixes.Get(context.Background(), uuid)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return prefix, http.StatusText(http.StatusNotFound), nil
+			}
+			return nil, "", fmt.Errorf("Error retrieving BYOIP prefix: %s", err)
+		}
+
+		status := strings.ToLower(prefix.Status)
+		switch {
+		case status == byoipPrefixStatusActive:
+			return prefix, byoipPrefixStatusActive, nil
+		case status == byoipPrefixStatusFailed || prefix.FailureReason != "":
+			return nil, "", fmt.Errorf("BYOIP prefix %s failed: %s", prefix.Prefix, prefix.FailureReason)
+		default:
+			log.Printf("[DEBUG] BYOIP prefix (%s) status: %s", uuid, prefix.Status)
+			return prefix, byoipPrefixStatusInProgress, nil
+		}
+	}
+}
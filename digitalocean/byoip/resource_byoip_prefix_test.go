@@ -0,0 +1,110 @@
+package byoip_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	byoipPrefixEnvVar    = "DO_TEST_BYOIP_PREFIX"
+	byoipSignatureEnvVar = "DO_TEST_BYOIP_SIGNATURE"
+)
+
+// testAccBYOIPPrefixFromEnv returns a prefix, and the signature proving its
+// ownership, that may be brought to the account under test. The tests share
+// the prefix, so they use resource.Test rather than resource.ParallelTest.
+func testAccBYOIPPrefixFromEnv(t *testing.T) (string, string) {
+	prefix := os.Getenv(byoipPrefixEnvVar)
+	signature := os.Getenv(byoipSignatureEnvVar)
+	if prefix == "" || signature == "" {
+		t.Skipf("Test requires an IP prefix owned by the account. Set %s and %s", byoipPrefixEnvVar, byoipSignatureEnvVar)
+	}
+
+	return prefix, signature
+}
+
+func TestAccDigitalOceanBYOIPPrefix_Basic(t *testing.T) {
+	prefix, signature := testAccBYOIPPrefixFromEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanBYOIPPrefixDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanBYOIPPrefixConfig_basic, prefix, signature),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanBYOIPPrefixExists("digitalocean_byoip_prefix.foobar"),
+					resource.TestCheckResourceAttr("digitalocean_byoip_prefix.foobar", "prefix", prefix),
+					resource.TestCheckResourceAttr("digitalocean_byoip_prefix.foobar", "region", "nyc3"),
+					resource.TestCheckResourceAttr("digitalocean_byoip_prefix.foobar", "status", "active"),
+					resource.TestCheckResourceAttrSet("digitalocean_byoip_prefix.foobar", "uuid"),
+				),
+			},
+			{
+				ResourceName:            "digitalocean_byoip_prefix.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"signature"},
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanBYOIPPrefixExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No BYOIP prefix ID is set")
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		prefix, _, err := client.BYOIPPrefixes.Get(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if prefix.UUID != rs.Primary.ID {
+			return fmt.Errorf("BYOIP prefix not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanBYOIPPrefixDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_byoip_prefix" {
+			continue
+		}
+
+		_, _, err := client.BYOIPPrefixes.Get(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("BYOIP prefix still exists")
+		}
+	}
+
+	return nil
+}
+
+const testAccCheckDigitalOceanBYOIPPrefixConfig_basic = `
+resource "digitalocean_byoip_prefix" "foobar" {
+  prefix    = "%s"
+  signature = "%s"
+  region    = "nyc3"
+}
+`
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/pricing"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"golang.org/x/oauth2"
 )
@@ -35,10 +36,15 @@ type CombinedConfig struct {
 	spacesEndpointTemplate *template.Template
 	accessID               string
 	secretKey              string
+	priceCatalog           *pricing.Catalog
 }
 
 func (c *CombinedConfig) GodoClient() *godo.Client { return c.client }
 
+// PriceCatalog returns the size catalogs used to price resources. They are
+// retrieved once and shared by all resources.
+func (c *CombinedConfig) PriceCatalog() *pricing.Catalog { return c.priceCatalog }
+
 func (c *CombinedConfig) SpacesClient(region string) (*session.Session, error) {
 	if c.accessID == "" || c.secretKey == "" {
 		err := fmt.Errorf("Spaces credentials not configured")
@@ -127,5 +133,6 @@ func (c *Config) Client() (*CombinedConfig, error) {
 		spacesEndpointTemplate: spacesEndpointTemplate,
 		accessID:               c.AccessID,
 		secretKey:              c.SecretKey,
+		priceCatalog:           pricing.NewCatalog(godoClient),
 	}, nil
 }
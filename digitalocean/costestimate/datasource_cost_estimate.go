@@ -0,0 +1,232 @@
+package costestimate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/pricing"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/project"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// errUnpriced is returned for resources whose price can not be determined
+// from the catalogs of the API.
+var errUnpriced = errors.New("resource type is not priced")
+
+func DataSourceDigitalOceanCostEstimate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanCostEstimateRead,
+		Schema: map[string]*schema.Schema{
+			"urns": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"urns", "project_id"},
+				Description:  "URNs of the resources to estimate the cost of",
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"urns", "project_id"},
+				Description:  "ID of a project whose resources to estimate the cost of",
+			},
+			"price_monthly": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Total monthly price of the priced resources in USD",
+			},
+			"price_hourly": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Total hourly price of the priced resources in USD",
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Price of each priced resource",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"urn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"price_monthly": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"price_hourly": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"unpriced_urns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "URNs of the resources whose price could not be determined",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanCostEstimateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+	catalog := meta.(*config.CombinedConfig).PriceCatalog()
+
+	var urns []string
+	if v, ok := d.GetOk("project_id"); ok {
+		projectURNs, err := project.LoadResourceURNs(client, v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		urns = *projectURNs
+	} else {
+		for _, urn := range d.Get("urns").(*schema.Set).List() {
+			urns = append(urns, urn.(string))
+		}
+	}
+	sort.Strings(urns)
+
+	var total pricing.Price
+	resources := make([]interface{}, 0, len(urns))
+	unpriced := make([]string, 0)
+	listPriced := make([]string, 0)
+	for _, urn := range urns {
+		price, listPrice, err := resourcePrice(ctx, client, catalog, urn)
+		if err != nil {
+			if errors.Is(err, errUnpriced) || errors.Is(err, pricing.ErrPriceNotFound) {
+				log.Printf("[DEBUG] Unable to determine the price of %s: %s", urn, err)
+				unpriced = append(unpriced, urn)
+				continue
+			}
+
+			return diag.Errorf("Error estimating the cost of %s: %s", urn, err)
+		}
+
+		if listPrice {
+			listPriced = append(listPriced, urn)
+		}
+
+		price = price.Rounded()
+		total = total.Add(price)
+		resources = append(resources, map[string]interface{}{
+			"urn":           urn,
+			"price_monthly": price.Monthly,
+			"price_hourly":  price.Hourly,
+		})
+	}
+	total = total.Rounded()
+
+	d.SetId(id.UniqueId())
+	d.Set("price_monthly", total.Monthly)
+	d.Set("price_hourly", total.Hourly)
+
+	if err := d.Set("resources", resources); err != nil {
+		return diag.Errorf("Error setting resources: %s", err)
+	}
+	if err := d.Set("unpriced_urns", unpriced); err != nil {
+		return diag.Errorf("Error setting unpriced_urns: %s", err)
+	}
+
+	if len(listPriced) > 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Cost estimate includes hardcoded list prices",
+			Detail: fmt.Sprintf("The API does not provide prices for volumes, load balancers and highly available Kubernetes control planes, "+
+				"so the prices of the following resources are based on list prices hardcoded in the provider, "+
+				"which do not follow changes to the published prices or reflect custom pricing: %s", strings.Join(listPriced, ", ")),
+		}}
+	}
+
+	return nil
+}
+
+// resourcePrice returns the price of the resource identified by a URN, e.g.
+// do:droplet:123, and whether it is based on a list price hardcoded in the
+// pricing package rather than on a catalog of the API. Droplets, Kubernetes
+// clusters, apps, volumes and load balancers are priced. Database clusters are
+// not, as the API does not provide their prices.
+func resourcePrice(ctx context.Context, client *godo.Client, catalog *pricing.Catalog, urn string) (pricing.Price, bool, error) {
+	parts := strings.SplitN(urn, ":", 3)
+	if len(parts) != 3 || parts[0] != "do" {
+		return pricing.Price{}, false, fmt.Errorf("%w: invalid URN", errUnpriced)
+	}
+
+	resourceType, resourceID := parts[1], parts[2]
+	switch resourceType {
+	case "droplet":
+		dropletID, err := strconv.Atoi(resourceID)
+		if err != nil {
+			return pricing.Price{}, false, fmt.Errorf("invalid Droplet ID: %s", err)
+		}
+
+		droplet, _, err := client.Droplets.Get(ctx, dropletID)
+		if err != nil {
+			return pricing.Price{}, false, fmt.Errorf("Error retrieving Droplet: %s", err)
+		}
+
+		price, err := catalog.DropletSize(ctx, droplet.SizeSlug)
+		return price, false, err
+
+	case "kubernetes":
+		cluster, _, err := client.Kubernetes.Get(ctx, resourceID)
+		if err != nil {
+			return pricing.Price{}, false, fmt.Errorf("Error retrieving Kubernetes cluster: %s", err)
+		}
+
+		var price pricing.Price
+		for _, pool := range cluster.NodePools {
+			nodePrice, err := catalog.DropletSize(ctx, pool.Size)
+			if err != nil {
+				return pricing.Price{}, false, err
+			}
+			price = price.Add(nodePrice.Times(pool.Count))
+		}
+		if cluster.HA {
+			price = price.Add(catalog.KubernetesHAControlPlane())
+		}
+
+		return price, cluster.HA, nil
+
+	case "app":
+		app, _, err := client.Apps.Get(ctx, resourceID)
+		if err != nil {
+			return pricing.Price{}, false, fmt.Errorf("Error retrieving app: %s", err)
+		}
+
+		price, err := catalog.AppSpec(ctx, app.Spec)
+		return price, false, err
+
+	case "volume":
+		volume, _, err := client.Storage.GetVolume(ctx, resourceID)
+		if err != nil {
+			return pricing.Price{}, false, fmt.Errorf("Error retrieving volume: %s", err)
+		}
+
+		return catalog.Volume(int(volume.SizeGigaBytes)), true, nil
+
+	case "loadbalancer":
+		lb, _, err := client.LoadBalancers.Get(ctx, resourceID)
+		if err != nil {
+			return pricing.Price{}, false, fmt.Errorf("Error retrieving load balancer: %s", err)
+		}
+
+		price, err := catalog.LoadBalancer(lb.Type, int(lb.SizeUnit), lb.SizeSlug)
+		return price, true, err
+	}
+
+	return pricing.Price{}, false, errUnpriced
+}
@@ -0,0 +1,88 @@
+package costestimate_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanCostEstimate_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	resourcesConfig := fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s"
+  size   = "s-1vcpu-1gb"
+  image  = "ubuntu-22-04-x64"
+  region = "nyc3"
+}
+
+resource "digitalocean_volume" "foobar" {
+  name   = "%s"
+  region = "nyc3"
+  size   = 10
+}
+
+resource "digitalocean_domain" "foobar" {
+  name = "%s.com"
+}
+
+resource "digitalocean_project" "foobar" {
+  name      = "%s"
+  resources = [digitalocean_droplet.foobar.urn, digitalocean_volume.foobar.urn]
+}
+`, name, name, name, name)
+
+	dataSourceConfig := `
+data "digitalocean_cost_estimate" "urns" {
+  urns = [
+    digitalocean_droplet.foobar.urn,
+    digitalocean_volume.foobar.urn,
+    digitalocean_domain.foobar.urn,
+  ]
+}
+
+data "digitalocean_cost_estimate" "project" {
+  project_id = digitalocean_project.foobar.id
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourcesConfig,
+			},
+			{
+				Config: resourcesConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_cost_estimate.urns", "resources.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_cost_estimate.urns", "resources.0.urn", "digitalocean_droplet.foobar", "urn"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_cost_estimate.urns", "resources.1.urn", "digitalocean_volume.foobar", "urn"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_cost_estimate.urns", "resources.1.price_monthly", "1"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_cost_estimate.urns", "price_monthly"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_cost_estimate.urns", "price_hourly"),
+					// Domains are free, so they are not priced.
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_cost_estimate.urns", "unpriced_urns.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_cost_estimate.urns", "unpriced_urns.0", "digitalocean_domain.foobar", "urn"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_cost_estimate.project", "price_monthly", "data.digitalocean_cost_estimate.urns", "price_monthly"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_cost_estimate.project", "resources.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_cost_estimate.project", "unpriced_urns.#", "0"),
+				),
+			},
+		},
+	})
+}
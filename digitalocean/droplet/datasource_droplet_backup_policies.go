@@ -0,0 +1,210 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanDropletBackupPolicies() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        dropletBackupPolicySchema(),
+		ResultAttributeName: "policies",
+		GetRecords:          getDigitalOceanDropletBackupPolicies,
+		FlattenRecord:       flattenDigitalOceanDropletBackupPolicy,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func DataSourceDigitalOceanDropletSupportedBackupPolicies() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        supportedBackupPolicySchema(),
+		ResultAttributeName: "policies",
+		GetRecords:          getDigitalOceanSupportedBackupPolicies,
+		FlattenRecord:       flattenDigitalOceanSupportedBackupPolicy,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func dropletBackupPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"droplet_id": {
+			Type:        schema.TypeInt,
+			Description: "id of the Droplet",
+		},
+		"backup_enabled": {
+			Type:        schema.TypeBool,
+			Description: "whether backups are enabled for the Droplet",
+		},
+		"plan": {
+			Type:        schema.TypeString,
+			Description: "the backup plan used for the Droplet",
+		},
+		"weekday": {
+			Type:        schema.TypeString,
+			Description: "the day of the week on which the backup occurs",
+		},
+		"hour": {
+			Type:        schema.TypeInt,
+			Description: "the hour of the day that the backup window starts",
+		},
+		"window_length_hours": {
+			Type:        schema.TypeInt,
+			Description: "the length of the backup window in hours",
+		},
+		"retention_period_days": {
+			Type:        schema.TypeInt,
+			Description: "the number of days backups are kept",
+		},
+		"next_backup_window_start": {
+			Type:        schema.TypeString,
+			Description: "the start of the next backup window",
+		},
+		"next_backup_window_end": {
+			Type:        schema.TypeString,
+			Description: "the end of the next backup window",
+		},
+	}
+}
+
+func supportedBackupPolicySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the backup plan",
+		},
+		"possible_window_starts": {
+			Type:        schema.TypeList,
+			Description: "the hours of the day at which a backup window may start",
+			Elem:        &schema.Schema{Type: schema.TypeInt},
+		},
+		"window_length_hours": {
+			Type:        schema.TypeInt,
+			Description: "the length of the backup window in hours",
+		},
+		"retention_period_days": {
+			Type:        schema.TypeInt,
+			Description: "the number of days backups are kept",
+		},
+		"possible_days": {
+			Type:        schema.TypeList,
+			Description: "the days of the week on which a backup may occur",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func getDigitalOceanDropletBackupPolicies(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var policyList []*godo.DropletBackupPolicy
+
+	for {
+		policies, resp, err := client.Droplets.ListBackupPolicies(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving droplet backup policies: %s", err)
+		}
+
+		for _, policy := range policies {
+			policyList = append(policyList, policy)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving droplet backup policies: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	// The policies are returned keyed by Droplet ID, so they are sorted to
+	// keep the results stable.
+	sort.Slice(policyList, func(i, j int) bool {
+		return policyList[i].DropletID < policyList[j].DropletID
+	})
+
+	records := make([]interface{}, 0, len(policyList))
+	for _, policy := range policyList {
+		records = append(records, *policy)
+	}
+
+	return records, nil
+}
+
+func flattenDigitalOceanDropletBackupPolicy(rawPolicy, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	policy, ok := rawPolicy.(godo.DropletBackupPolicy)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.DropletBackupPolicy")
+	}
+
+	flattenedPolicy := map[string]interface{}{
+		"droplet_id":     policy.DropletID,
+		"backup_enabled": policy.BackupEnabled,
+	}
+
+	if backupPolicy := policy.BackupPolicy; backupPolicy != nil {
+		flattenedPolicy["plan"] = backupPolicy.Plan
+		flattenedPolicy["weekday"] = backupPolicy.Weekday
+		flattenedPolicy["hour"] = backupPolicy.Hour
+		flattenedPolicy["window_length_hours"] = backupPolicy.WindowLengthHours
+		flattenedPolicy["retention_period_days"] = backupPolicy.RetentionPeriodDays
+	}
+
+	if window := policy.NextBackupWindow; window != nil {
+		if window.Start != nil {
+			flattenedPolicy["next_backup_window_start"] = window.Start.UTC().String()
+		}
+		if window.End != nil {
+			flattenedPolicy["next_backup_window_end"] = window.End.UTC().String()
+		}
+	}
+
+	return flattenedPolicy, nil
+}
+
+func getDigitalOceanSupportedBackupPolicies(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	policies, _, err := client.Droplets.ListSupportedBackupPolicies(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving supported droplet backup policies: %s", err)
+	}
+
+	records := make([]interface{}, 0, len(policies))
+	for _, policy := range policies {
+		records = append(records, *policy)
+	}
+
+	return records, nil
+}
+
+func flattenDigitalOceanSupportedBackupPolicy(rawPolicy, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	policy, ok := rawPolicy.(godo.SupportedBackupPolicy)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.SupportedBackupPolicy")
+	}
+
+	return map[string]interface{}{
+		"name":                   policy.Name,
+		"possible_window_starts": policy.PossibleWindowStarts,
+		"window_length_hours":    policy.WindowLengthHours,
+		"retention_period_days":  policy.RetentionPeriodDays,
+		"possible_days":          policy.PossibleDays,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package droplet_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDropletBackupPolicies_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name    = "%s"
+  size    = "%s"
+  image   = "%s"
+  region  = "nyc3"
+  backups = true
+
+  backup_policy {
+    plan    = "weekly"
+    weekday = "TUE"
+    hour    = 8
+  }
+}
+`, name, defaultSize, defaultImage)
+
+	dataSourceConfig := `
+data "digitalocean_droplet_backup_policies" "foobar" {
+  filter {
+    key    = "droplet_id"
+    values = [digitalocean_droplet.foobar.id]
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_backup_policies.foobar", "policies.#", "1"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_droplet_backup_policies.foobar", "policies.0.droplet_id", "digitalocean_droplet.foobar", "id"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_backup_policies.foobar", "policies.0.backup_enabled", "true"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_backup_policies.foobar", "policies.0.plan", "weekly"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_backup_policies.foobar", "policies.0.weekday", "TUE"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_backup_policies.foobar", "policies.0.hour", "8"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_backup_policies.foobar", "policies.0.retention_period_days"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanDropletSupportedBackupPolicies_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "digitalocean_droplet_supported_backup_policies" "weekly" {
+  filter {
+    key    = "name"
+    values = ["weekly"]
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_supported_backup_policies.weekly", "policies.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_supported_backup_policies.weekly", "policies.0.name", "weekly"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_supported_backup_policies.weekly", "policies.0.window_length_hours"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_supported_backup_policies.weekly", "policies.0.retention_period_days"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_supported_backup_policies.weekly", "policies.0.possible_window_starts.#"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_supported_backup_policies.weekly", "policies.0.possible_days.#"),
+				),
+			},
+		},
+	})
+}
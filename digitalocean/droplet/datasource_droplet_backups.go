@@ -0,0 +1,130 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDropletBackups() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        dropletBackupSchema(),
+		ResultAttributeName: "backups",
+		GetRecords:          getDigitalOceanDropletBackups,
+		FlattenRecord:       flattenDigitalOceanDropletBackup,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func dropletBackupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeInt,
+			Description: "id of the backup",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the backup",
+		},
+		"distribution": {
+			Type:        schema.TypeString,
+			Description: "distribution of the OS of the backup",
+		},
+		"min_disk_size": {
+			Type:        schema.TypeInt,
+			Description: "minimum disk size required to restore the backup",
+		},
+		"size_gigabytes": {
+			Type:        schema.TypeFloat,
+			Description: "size in GB of the backup",
+		},
+		"regions": {
+			Type:        schema.TypeSet,
+			Description: "list of the regions that the backup is available in",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the creation date of the backup",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "status of the backup",
+		},
+	}
+}
+
+func getDigitalOceanDropletBackups(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	dropletID := extra["droplet_id"].(int)
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var backupList []interface{}
+
+	for {
+		backups, resp, err := client.Droplets.Backups(context.Background(), dropletID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving backups for droplet (%d): %s", dropletID, err)
+		}
+
+		for _, backup := range backups {
+			backupList = append(backupList, backup)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving backups for droplet (%d): %s", dropletID, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return backupList, nil
+}
+
+func flattenDigitalOceanDropletBackup(rawBackup, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	backup, ok := rawBackup.(godo.Image)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to godo.Image")
+	}
+
+	flattenedRegions := schema.NewSet(schema.HashString, []interface{}{})
+	for _, region := range backup.Regions {
+		flattenedRegions.Add(region)
+	}
+
+	flattenedBackup := map[string]interface{}{
+		"id":             backup.ID,
+		"name":           backup.Name,
+		"distribution":   backup.Distribution,
+		"min_disk_size":  backup.MinDiskSize,
+		"size_gigabytes": backup.SizeGigaBytes,
+		"regions":        flattenedRegions,
+		"created_at":     backup.Created,
+		"status":         backup.Status,
+	}
+
+	return flattenedBackup, nil
+}
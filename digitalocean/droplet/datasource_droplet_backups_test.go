@@ -0,0 +1,51 @@
+package droplet_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDropletBackups_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name    = "%s"
+  size    = "%s"
+  image   = "%s"
+  region  = "nyc3"
+  backups = true
+}
+`, name, defaultSize, defaultImage)
+
+	dataSourceConfig := `
+data "digitalocean_droplet_backups" "foobar" {
+  droplet_id = digitalocean_droplet.foobar.id
+
+  sort {
+    key       = "created_at"
+    direction = "desc"
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Backups are only taken once the backup window has passed, so a
+				// newly created Droplet has none.
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.digitalocean_droplet_backups.foobar", "droplet_id", "digitalocean_droplet.foobar", "id"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_backups.foobar", "backups.#", "0"),
+				),
+			},
+		},
+	})
+}
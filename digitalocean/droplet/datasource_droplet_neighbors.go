@@ -0,0 +1,47 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDropletNeighbors() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        dropletSchema(),
+		ResultAttributeName: "droplets",
+		GetRecords:          getDigitalOceanDropletNeighbors,
+		FlattenRecord:       flattenDigitalOceanDroplet,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanDropletNeighbors(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	dropletID := extra["droplet_id"].(int)
+
+	neighbors, _, err := client.Droplets.Neighbors(context.Background(), dropletID)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving neighbors of droplet (%d): %s", dropletID, err)
+	}
+
+	neighborList := make([]interface{}, 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		neighborList = append(neighborList, neighbor)
+	}
+
+	return neighborList, nil
+}
@@ -21,8 +21,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+const (
+	dropletAntiAffinityModeWarn = "warn"
+	dropletAntiAffinityModeFail = "fail"
+
+	dropletPowerStateOn       = "on"
+	dropletPowerStateOff      = "off"
+	dropletPowerStateShutdown = "shutdown"
+)
+
 var (
-	errDropletBackupPolicy = errors.New("backup_policy can only be set when backups are enabled")
+	errDropletBackupPolicy    = errors.New("backup_policy can only be set when backups are enabled")
+	errDropletRestoreOnCreate = errors.New("restore_from_backup_id can only be set on an existing droplet, as a droplet can only be restored from its own backups")
 )
 
 func ResourceDigitalOceanDroplet() *schema.Resource {
@@ -51,7 +61,29 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 			"image": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"rebuild_on_image_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"auto_transfer_image": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"image_transfer_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"restore_from_backup_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
 
@@ -89,6 +121,17 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 				Default:  false,
 			},
 
+			"power_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					dropletPowerStateOn,
+					dropletPowerStateOff,
+					dropletPowerStateShutdown,
+				}, false),
+			},
+
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -250,6 +293,30 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 
 			"tags": tag.TagsSchema(),
 
+			"anti_affinity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tag": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: tag.ValidateTag,
+						},
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  dropletAntiAffinityModeWarn,
+							ValidateFunc: validation.StringInSlice([]string{
+								dropletAntiAffinityModeWarn,
+								dropletAntiAffinityModeFail,
+							}, false),
+						},
+					},
+				},
+			},
+
 			"vpc_uuid": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -260,6 +327,14 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
+			// A Droplet can only be restored from one of its own backups, so
+			// restoring is rejected when the Droplet is being created.
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if _, ok := d.GetOk("restore_from_backup_id"); ok && d.Id() == "" {
+					return errDropletRestoreOnCreate
+				}
+				return nil
+			},
 			// If the `ipv6` attribute is changed to `true`, we need to mark the
 			// `ipv6_address` attribute as changing in the plan. If not, the plan
 			// will become inconsistent once the address is known when referenced
@@ -280,6 +355,42 @@ func ResourceDigitalOceanDroplet() *schema.Resource {
 					return old.(bool) && !new.(bool)
 				},
 			),
+			// Changing the image replaces the Droplet unless it has opted in to
+			// being rebuilt in place, which preserves its ID and IP addresses.
+			customdiff.ForceNewIf("image", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.Id() != "" && d.HasChange("image") && !d.Get("rebuild_on_image_change").(bool)
+			}),
+			// Report at plan time whether a private image will be transferred
+			// to the Droplet's region before it is created.
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if d.Id() != "" {
+					return nil
+				}
+				if !d.Get("auto_transfer_image").(bool) {
+					return d.SetNew("image_transfer_required", false)
+				}
+				if !d.NewValueKnown("image") || !d.NewValueKnown("region") {
+					return d.SetNewComputed("image_transfer_required")
+				}
+
+				image, region := d.Get("image").(string), d.Get("region").(string)
+				_, required, err := dropletImageTransferRequired(meta.(*config.CombinedConfig).GodoClient(), image, region)
+				if err != nil {
+					return err
+				}
+
+				return d.SetNew("image_transfer_required", required)
+			},
+			// Validate the backup policy against the plans supported by the
+			// API, so that an unsupported policy is caught at plan time.
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				policy, ok := d.GetOk("backup_policy")
+				if !ok || !d.HasChange("backup_policy") || !d.NewValueKnown("backup_policy") {
+					return nil
+				}
+
+				return validateDropletBackupPolicy(meta.(*config.CombinedConfig).GodoClient(), policy)
+			},
 		),
 	}
 }
@@ -376,6 +487,43 @@ func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceDa
 		opts.BackupPolicy = backupPolicy
 	}
 
+	var diags diag.Diagnostics
+	imageTransferRequired := false
+	if d.Get("auto_transfer_image").(bool) {
+		imageID, required, err := dropletImageTransferRequired(client, image, opts.Region)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		imageTransferRequired = required
+
+		if required {
+			log.Printf("[INFO] Transferring image (%d) to: %s", imageID, opts.Region)
+			action, _, err := client.ImageActions.Transfer(context.Background(), imageID, &godo.ActionRequest{
+				"type":   "transfer",
+				"region": opts.Region,
+			})
+			if err != nil {
+				return diag.Errorf("Error transferring image (%d) to %s: %s", imageID, opts.Region, err)
+			}
+
+			if err = util.WaitForAction(client, action); err != nil {
+				return diag.Errorf("Error waiting for image (%d) to be transferred to %s: %s", imageID, opts.Region, err)
+			}
+
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Image transferred to the Droplet's region",
+				Detail:   fmt.Sprintf("Image %d was not available in %s and has been transferred there.", imageID, opts.Region),
+			})
+		}
+	}
+
+	d.Set("image_transfer_required", imageTransferRequired)
+
+	// The desired power state is read before waiting for the Droplet, as
+	// waiting refreshes power_state from the Droplet's status.
+	powerState := d.Get("power_state").(string)
+
 	log.Printf("[DEBUG] Droplet create configuration: %#v", opts)
 
 	droplet, _, err := client.Droplets.Create(context.Background(), opts)
@@ -393,9 +541,84 @@ func resourceDigitalOceanDropletCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("Error waiting for droplet (%s) to become ready: %s", d.Id(), err)
 	}
 
+	if powerState != "" && powerState != dropletPowerStateOn {
+		err = setDropletPowerState(ctx, d, meta, powerState)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// waitForDropletAttribute updates the Droplet's state and calls setDropletAttributes.
 	// So there is no need to call resourceDigitalOceanDropletRead and add additional API calls.
-	return nil
+	return append(diags, checkDropletAntiAffinity(client, d)...)
+}
+
+// dropletImageTransferRequired reports whether the given image must be
+// transferred to the region before a Droplet can be created from it. Only
+// private images and snapshots, which are referenced by ID, can be missing
+// from a region.
+func dropletImageTransferRequired(client *godo.Client, image string, region string) (int, bool, error) {
+	imageID, err := strconv.Atoi(image)
+	if err != nil || region == "" {
+		return imageID, false, nil
+	}
+
+	img, _, err := client.Images.GetByID(context.Background(), imageID)
+	if err != nil {
+		return imageID, false, fmt.Errorf("Error retrieving image (%d): %s", imageID, err)
+	}
+
+	if img.Public || containsString(img.Regions, strings.ToLower(region)) {
+		return imageID, false, nil
+	}
+
+	return imageID, true, nil
+}
+
+// checkDropletAntiAffinity reports whether a newly created Droplet shares a
+// host with any Droplet carrying the configured anti-affinity tag. Placement
+// cannot be requested from the API, so this can only be checked afterwards.
+func checkDropletAntiAffinity(client *godo.Client, d *schema.ResourceData) diag.Diagnostics {
+	v, ok := d.GetOk("anti_affinity")
+	if !ok {
+		return nil
+	}
+
+	antiAffinity := v.([]interface{})[0].(map[string]interface{})
+	antiAffinityTag := antiAffinity["tag"].(string)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("invalid droplet id: %v", err)
+	}
+
+	neighbors, _, err := client.Droplets.Neighbors(context.Background(), id)
+	if err != nil {
+		return diag.Errorf("Error retrieving neighbors of droplet (%s): %s", d.Id(), err)
+	}
+
+	var conflicts []string
+	for _, neighbor := range neighbors {
+		if neighbor.ID != id && containsString(neighbor.Tags, antiAffinityTag) {
+			conflicts = append(conflicts, fmt.Sprintf("%s (%d)", neighbor.Name, neighbor.ID))
+		}
+	}
+
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	severity := diag.Warning
+	if antiAffinity["mode"].(string) == dropletAntiAffinityModeFail {
+		severity = diag.Error
+	}
+
+	return diag.Diagnostics{{
+		Severity: severity,
+		Summary:  "Droplet shares a host with Droplets it should not be placed with",
+		Detail: fmt.Sprintf("Droplet %s was placed on the same host as the following Droplets tagged %s: %s.",
+			d.Id(), antiAffinityTag, strings.Join(conflicts, ", ")),
+	}}
 }
 
 func resourceDigitalOceanDropletRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -444,6 +667,16 @@ func setDropletAttributes(d *schema.ResourceData, droplet *godo.Droplet) error {
 	d.Set("memory", droplet.Memory)
 	d.Set("status", droplet.Status)
 	d.Set("locked", droplet.Locked)
+	switch droplet.Status {
+	case "active":
+		d.Set("power_state", dropletPowerStateOn)
+	case "off":
+		// A Droplet that was shut down gracefully is indistinguishable from
+		// one that was powered off, so either is accepted for an off Droplet.
+		if d.Get("power_state").(string) != dropletPowerStateShutdown {
+			d.Set("power_state", dropletPowerStateOff)
+		}
+	}
 	d.Set("created_at", droplet.Created)
 	d.Set("vpc_uuid", droplet.VPCUUID)
 
@@ -494,8 +727,11 @@ func resourceDigitalOceanDropletImport(d *schema.ResourceData, meta interface{})
 		d.Set("image", godo.Stringify(droplet.Image.ID))
 	}
 
-	// This is a non API attribute. So set to the default setting in the schema.
+	// These are non API attributes. So set to the default setting in the schema.
 	d.Set("resize_disk", true)
+	d.Set("rebuild_on_image_change", false)
+	d.Set("auto_transfer_image", false)
+	d.Set("image_transfer_required", false)
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -531,6 +767,51 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("invalid droplet id: %v", err)
 	}
 
+	// The desired power state is read before any other updates, as waiting
+	// on them refreshes power_state from the Droplet's status.
+	powerState := d.Get("power_state").(string)
+
+	if d.HasChange("image") {
+		image := d.Get("image").(string)
+
+		action, err := rebuildDroplet(ctx, client, id, image)
+		if err != nil {
+			return diag.Errorf("Error rebuilding droplet (%s) from image %s: %s", d.Id(), image, err)
+		}
+
+		if err = util.WaitForAction(client, action); err != nil {
+			return diag.Errorf("Error waiting for rebuild of droplet (%s) to finish: %s", d.Id(), err)
+		}
+
+		// Wait for the droplet to become active again
+		_, err = waitForDropletAttribute(ctx, d, "active", []string{"new", "off"}, "status", schema.TimeoutUpdate, meta)
+		if err != nil {
+			return diag.Errorf("Error waiting for droplet (%s) to become active after rebuild: %s", d.Id(), err)
+		}
+	}
+
+	// Restoring only applies to existing Droplets, as a Droplet can only be
+	// restored from one of its own backups.
+	if v, ok := d.GetOk("restore_from_backup_id"); ok && d.HasChange("restore_from_backup_id") {
+		backupID := v.(int)
+
+		log.Printf("[INFO] Restoring droplet (%s) from backup %d", d.Id(), backupID)
+		action, _, err := client.DropletActions.Restore(context.Background(), id, backupID)
+		if err != nil {
+			return diag.Errorf("Error restoring droplet (%s) from backup %d: %s", d.Id(), backupID, err)
+		}
+
+		if err = util.WaitForAction(client, action); err != nil {
+			return diag.Errorf("Error waiting for restore of droplet (%s) to finish: %s", d.Id(), err)
+		}
+
+		// Wait for the droplet to become active again
+		_, err = waitForDropletAttribute(ctx, d, "active", []string{"new", "off"}, "status", schema.TimeoutUpdate, meta)
+		if err != nil {
+			return diag.Errorf("Error waiting for droplet (%s) to become active after restore: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("size") {
 		newSize := d.Get("size")
 		resizeDisk := d.Get("resize_disk").(bool)
@@ -768,6 +1049,16 @@ func resourceDigitalOceanDropletUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	// Converge on the desired power state last, as resizing, rebuilding or
+	// restoring the Droplet leaves it powered on. Other updates leave the
+	// power state alone unless it was changed.
+	if powerState != "" && d.HasChanges("power_state", "size", "image", "restore_from_backup_id") {
+		err = setDropletPowerState(ctx, d, meta, powerState)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	readErr := resourceDigitalOceanDropletRead(ctx, d, meta)
 	if readErr != nil {
 		warnings = append(warnings, readErr...)
@@ -944,6 +1235,113 @@ func powerOnAndWait(ctx context.Context, d *schema.ResourceData, meta interface{
 	return nil
 }
 
+// rebuildDroplet rebuilds a Droplet from an image ID or slug.
+func rebuildDroplet(ctx context.Context, client *godo.Client, id int, image string) (*godo.Action, error) {
+	var action *godo.Action
+	var err error
+	if imageID, convErr := strconv.Atoi(image); convErr == nil {
+		action, _, err = client.DropletActions.RebuildByImageID(ctx, id, imageID)
+	} else {
+		action, _, err = client.DropletActions.RebuildByImageSlug(ctx, id, image)
+	}
+
+	return action, err
+}
+
+// Powers the droplet on or off so that it matches the given power state. A
+// graceful shutdown that does not finish in time falls back to a power off.
+func setDropletPowerState(ctx context.Context, d *schema.ResourceData, meta interface{}, powerState string) error {
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid droplet id: %v", err)
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+	droplet, _, err := client.Droplets.Get(context.Background(), id)
+	if err != nil {
+		return fmt.Errorf("Error retrieving droplet: %s", err)
+	}
+
+	switch powerState {
+	case dropletPowerStateOn:
+		if droplet.Status != "off" {
+			break
+		}
+
+		log.Printf("[INFO] Powering on droplet: %s", d.Id())
+		err = powerOnAndWait(ctx, d, meta)
+		if err != nil {
+			return fmt.Errorf("Error powering on droplet (%s): %s", d.Id(), err)
+		}
+	case dropletPowerStateShutdown:
+		if droplet.Status != "active" {
+			break
+		}
+
+		log.Printf("[INFO] Shutting down droplet: %s", d.Id())
+		_, _, err = client.DropletActions.Shutdown(context.Background(), id)
+		if err != nil {
+			return fmt.Errorf("Error shutting down droplet (%s): %s", d.Id(), err)
+		}
+
+		_, err = waitForDropletShutdown(ctx, d, meta)
+		if err == nil {
+			break
+		}
+
+		var timeoutErr *retry.TimeoutError
+		if !errors.As(err, &timeoutErr) {
+			return fmt.Errorf("Error waiting for droplet (%s) to shut down: %s", d.Id(), err)
+		}
+
+		log.Printf("[WARN] Droplet (%s) did not shut down within %s, powering it off", d.Id(), dropletShutdownTimeout(d))
+		fallthrough
+	case dropletPowerStateOff:
+		if droplet.Status != "active" {
+			break
+		}
+
+		log.Printf("[INFO] Powering off droplet: %s", d.Id())
+		_, _, err = client.DropletActions.PowerOff(context.Background(), id)
+		if err != nil && !strings.Contains(err.Error(), "Droplet is already powered off") {
+			return fmt.Errorf("Error powering off droplet (%s): %s", d.Id(), err)
+		}
+
+		_, err = waitForDropletAttribute(ctx, d, "off", []string{"active"}, "status", schema.TimeoutUpdate, meta)
+		if err != nil {
+			return fmt.Errorf("Error waiting for droplet (%s) to become powered off: %s", d.Id(), err)
+		}
+	}
+
+	// The status of a Droplet that has been shut down does not record how it
+	// was turned off, so the desired power state is kept as-is.
+	d.Set("power_state", powerState)
+
+	return nil
+}
+
+// dropletShutdownTimeout is how long a graceful shutdown may take before the
+// Droplet is forcibly powered off instead. It is half of the update timeout,
+// leaving the rest for powering the Droplet off.
+func dropletShutdownTimeout(d *schema.ResourceData) time.Duration {
+	return d.Timeout(schema.TimeoutUpdate) / 2
+}
+
+func waitForDropletShutdown(ctx context.Context, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	log.Printf("[INFO] Waiting for droplet (%s) to shut down", d.Id())
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"active"},
+		Target:     []string{"off"},
+		Refresh:    dropletStateRefreshFunc(ctx, d, "status", meta),
+		Timeout:    dropletShutdownTimeout(d),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
 // Detach volumes from droplet
 func detachVolumesFromDroplet(d *schema.ResourceData, meta interface{}) error {
 	var errors []error
@@ -1018,6 +1416,62 @@ func flattenDigitalOceanDropletVolumeIds(volumeids []string) *schema.Set {
 	return flattenedVolumes
 }
 
+// validateDropletBackupPolicy checks that the plan, weekday and hour of the
+// given backup policy are supported by the API.
+func validateDropletBackupPolicy(client *godo.Client, v interface{}) error {
+	policy, err := expandBackupPolicy(v)
+	if err != nil {
+		return err
+	}
+
+	if policy.Plan == "" {
+		return nil
+	}
+
+	supported, _, err := client.Droplets.ListSupportedBackupPolicies(context.Background())
+	if err != nil {
+		return fmt.Errorf("Error retrieving supported droplet backup policies: %s", err)
+	}
+
+	plans := make([]string, 0, len(supported))
+	for _, s := range supported {
+		if s.Name != policy.Plan {
+			plans = append(plans, s.Name)
+			continue
+		}
+
+		if policy.Weekday != "" && len(s.PossibleDays) > 0 && !containsString(s.PossibleDays, policy.Weekday) {
+			return fmt.Errorf("backup_policy weekday %s is not supported by the %s plan, expected one of %v", policy.Weekday, policy.Plan, s.PossibleDays)
+		}
+
+		if policy.Hour != nil && len(s.PossibleWindowStarts) > 0 && !containsInt(s.PossibleWindowStarts, *policy.Hour) {
+			return fmt.Errorf("backup_policy hour %d is not supported by the %s plan, expected one of %v", *policy.Hour, policy.Plan, s.PossibleWindowStarts)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("backup_policy plan %s is not supported, expected one of %v", policy.Plan, plans)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 func expandBackupPolicy(v interface{}) (*godo.DropletBackupPolicyRequest, error) {
 	var policy godo.DropletBackupPolicyRequest
 	policyList := v.([]interface{})
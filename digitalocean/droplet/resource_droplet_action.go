@@ -0,0 +1,292 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	dropletActionReboot        = "reboot"
+	dropletActionPowerCycle    = "power_cycle"
+	dropletActionPowerOn       = "power_on"
+	dropletActionPowerOff      = "power_off"
+	dropletActionShutdown      = "shutdown"
+	dropletActionPasswordReset = "password_reset"
+	dropletActionChangeKernel  = "change_kernel"
+	dropletActionSnapshot      = "snapshot"
+)
+
+// dropletActionsWithoutTag are the actions the API can only perform on a
+// single Droplet rather than on all Droplets sharing a tag.
+var dropletActionsWithoutTag = []string{
+	dropletActionReboot,
+	dropletActionPasswordReset,
+	dropletActionChangeKernel,
+}
+
+// ResourceDigitalOceanDropletAction performs a one-off action on a Droplet, or
+// on all Droplets with a tag. The action is performed on create, so any change
+// to its arguments (most commonly `triggers`) results in it being performed again.
+func ResourceDigitalOceanDropletAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDropletActionCreate,
+		ReadContext:   resourceDigitalOceanDropletActionRead,
+		DeleteContext: resourceDigitalOceanDropletActionDelete,
+		CustomizeDiff: resourceDigitalOceanDropletActionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"droplet_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"droplet_id", "tag"},
+			},
+			"tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"droplet_id", "tag"},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					dropletActionReboot,
+					dropletActionPowerCycle,
+					dropletActionPowerOn,
+					dropletActionPowerOff,
+					dropletActionShutdown,
+					dropletActionPasswordReset,
+					dropletActionChangeKernel,
+					dropletActionSnapshot,
+				}, false),
+			},
+			"kernel_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"snapshot_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"droplet_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"started_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"completed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanDropletActionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Values that are only known after apply, such as a kernel ID read from
+	// another resource, can not be validated until then.
+	for _, key := range []string{"type", "tag", "kernel_id", "snapshot_name"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	actionType := d.Get("type").(string)
+
+	if _, ok := d.GetOk("tag"); ok {
+		for _, t := range dropletActionsWithoutTag {
+			if actionType == t {
+				return fmt.Errorf("the %s action can only be performed on a single Droplet, set droplet_id instead of tag", actionType)
+			}
+		}
+	}
+
+	if _, ok := d.GetOk("kernel_id"); ok != (actionType == dropletActionChangeKernel) {
+		return fmt.Errorf("kernel_id must be set for, and only for, the %s action", dropletActionChangeKernel)
+	}
+
+	if _, ok := d.GetOk("snapshot_name"); ok != (actionType == dropletActionSnapshot) {
+		return fmt.Errorf("snapshot_name must be set for, and only for, the %s action", dropletActionSnapshot)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanDropletActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	actionType := d.Get("type").(string)
+
+	var actions []godo.Action
+	if v, ok := d.GetOk("droplet_id"); ok {
+		dropletID := v.(int)
+
+		log.Printf("[INFO] Performing %s action on droplet %d", actionType, dropletID)
+		action, err := performDropletAction(client, d, dropletID)
+		if err != nil {
+			return diag.Errorf("Error performing %s action on droplet (%d): %s", actionType, dropletID, err)
+		}
+		actions = append(actions, *action)
+
+		d.SetId(id.PrefixedUniqueId(strconv.Itoa(dropletID) + "-"))
+	} else {
+		tag := d.Get("tag").(string)
+
+		log.Printf("[INFO] Performing %s action on droplets tagged %s", actionType, tag)
+		tagActions, err := performDropletActionByTag(client, d, tag)
+		if err != nil {
+			return diag.Errorf("Error performing %s action on droplets tagged %s: %s", actionType, tag, err)
+		}
+		actions = tagActions
+
+		d.SetId(id.PrefixedUniqueId(tag + "-"))
+	}
+
+	for i := range actions {
+		if err := util.WaitForAction(client, &actions[i]); err != nil {
+			return diag.Errorf("Error waiting for %s action on droplet (%d) to finish: %s", actionType, actions[i].ResourceID, err)
+		}
+
+		// Refresh the action to record its final status
+		action, _, err := client.Actions.Get(context.Background(), actions[i].ID)
+		if err != nil {
+			return diag.Errorf("Error retrieving action (%d): %s", actions[i].ID, err)
+		}
+		actions[i] = *action
+	}
+
+	if err := d.Set("actions", flattenDropletActions(actions)); err != nil {
+		return diag.Errorf("Error setting actions: %s", err)
+	}
+
+	return resourceDigitalOceanDropletActionRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDropletActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The action has already been performed, there is nothing to refresh.
+	return nil
+}
+
+func resourceDigitalOceanDropletActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Actions cannot be undone, so they are only removed from the Terraform state.
+	d.SetId("")
+	return nil
+}
+
+func performDropletAction(client *godo.Client, d *schema.ResourceData, dropletID int) (*godo.Action, error) {
+	var (
+		action *godo.Action
+		err    error
+	)
+
+	switch d.Get("type").(string) {
+	case dropletActionReboot:
+		action, _, err = client.DropletActions.Reboot(context.Background(), dropletID)
+	case dropletActionPowerCycle:
+		action, _, err = client.DropletActions.PowerCycle(context.Background(), dropletID)
+	case dropletActionPowerOn:
+		action, _, err = client.DropletActions.PowerOn(context.Background(), dropletID)
+	case dropletActionPowerOff:
+		action, _, err = client.DropletActions.PowerOff(context.Background(), dropletID)
+	case dropletActionShutdown:
+		action, _, err = client.DropletActions.Shutdown(context.Background(), dropletID)
+	case dropletActionPasswordReset:
+		action, _, err = client.DropletActions.PasswordReset(context.Background(), dropletID)
+	case dropletActionChangeKernel:
+		action, _, err = client.DropletActions.ChangeKernel(context.Background(), dropletID, d.Get("kernel_id").(int))
+	case dropletActionSnapshot:
+		action, _, err = client.DropletActions.Snapshot(context.Background(), dropletID, d.Get("snapshot_name").(string))
+	default:
+		err = fmt.Errorf("unsupported action type %s", d.Get("type").(string))
+	}
+
+	return action, err
+}
+
+func performDropletActionByTag(client *godo.Client, d *schema.ResourceData, tag string) ([]godo.Action, error) {
+	var (
+		actions []godo.Action
+		err     error
+	)
+
+	switch d.Get("type").(string) {
+	case dropletActionPowerCycle:
+		actions, _, err = client.DropletActions.PowerCycleByTag(context.Background(), tag)
+	case dropletActionPowerOn:
+		actions, _, err = client.DropletActions.PowerOnByTag(context.Background(), tag)
+	case dropletActionPowerOff:
+		actions, _, err = client.DropletActions.PowerOffByTag(context.Background(), tag)
+	case dropletActionShutdown:
+		actions, _, err = client.DropletActions.ShutdownByTag(context.Background(), tag)
+	case dropletActionSnapshot:
+		actions, _, err = client.DropletActions.SnapshotByTag(context.Background(), tag, d.Get("snapshot_name").(string))
+	default:
+		err = fmt.Errorf("the %s action cannot be performed by tag", d.Get("type").(string))
+	}
+
+	return actions, err
+}
+
+func flattenDropletActions(actions []godo.Action) []interface{} {
+	flattened := make([]interface{}, 0, len(actions))
+	for _, action := range actions {
+		a := map[string]interface{}{
+			"id":         action.ID,
+			"droplet_id": action.ResourceID,
+			"status":     action.Status,
+		}
+		if action.StartedAt != nil {
+			a["started_at"] = action.StartedAt.UTC().String()
+		}
+		if action.CompletedAt != nil {
+			a["completed_at"] = action.CompletedAt.UTC().String()
+		}
+		flattened = append(flattened, a)
+	}
+
+	return flattened
+}
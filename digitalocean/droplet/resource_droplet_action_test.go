@@ -0,0 +1,116 @@
+package droplet_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanDropletAction_Basic(t *testing.T) {
+	var droplet godo.Droplet
+	name := acceptance.RandomTestName()
+	dropletConfig := acceptance.TestAccCheckDigitalOceanDropletConfig_basic(name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: dropletConfig + testAccCheckDigitalOceanDropletActionConfig_reboot("first"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					resource.TestCheckResourceAttr("digitalocean_droplet_action.foobar", "type", "reboot"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_action.foobar", "actions.#", "1"),
+					resource.TestCheckResourceAttrSet("digitalocean_droplet_action.foobar", "actions.0.id"),
+					resource.TestCheckResourceAttrPair("digitalocean_droplet_action.foobar", "actions.0.droplet_id", "digitalocean_droplet.foobar", "id"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_action.foobar", "actions.0.status", "completed"),
+				),
+			},
+			// Changing the triggers performs the action again.
+			{
+				Config: dropletConfig + testAccCheckDigitalOceanDropletActionConfig_reboot("second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_droplet_action.foobar", "triggers.run", "second"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_action.foobar", "actions.0.status", "completed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDropletAction_ByTag(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "digitalocean_tag" "foobar" {
+  name = "%s"
+}
+
+resource "digitalocean_droplet" "foobar" {
+  count  = 2
+  name   = "%s-${count.index}"
+  size   = "%s"
+  image  = "%s"
+  region = "nyc3"
+  tags   = [digitalocean_tag.foobar.id]
+}
+
+resource "digitalocean_droplet_action" "foobar" {
+  tag  = digitalocean_tag.foobar.id
+  type = "power_cycle"
+
+  depends_on = [digitalocean_droplet.foobar]
+}
+`, name, name, defaultSize, defaultImage),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_droplet_action.foobar", "actions.#", "2"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_action.foobar", "actions.0.status", "completed"),
+					resource.TestCheckResourceAttr("digitalocean_droplet_action.foobar", "actions.1.status", "completed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDropletAction_RebootByTag(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "digitalocean_droplet_action" "foobar" {
+  tag  = "foobar"
+  type = "reboot"
+}
+`,
+				ExpectError: regexp.MustCompile("the reboot action can only be performed on a single Droplet"),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanDropletActionConfig_reboot(run string) string {
+	return fmt.Sprintf(`
+
+resource "digitalocean_droplet_action" "foobar" {
+  droplet_id = digitalocean_droplet.foobar.id
+  type       = "reboot"
+
+  triggers = {
+    run = "%s"
+  }
+}
+`, run)
+}
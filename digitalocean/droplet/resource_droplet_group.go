@@ -0,0 +1,587 @@
+package droplet
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dropletGroupBatchSize is the maximum number of Droplets the API accepts in
+// a single multi-create request.
+const dropletGroupBatchSize = 10
+
+// ResourceDigitalOceanDropletGroup manages a group of identical Droplets. The
+// members are created in batches and identified by a tag shared by the group.
+func ResourceDigitalOceanDropletGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanDropletGroupCreate,
+		ReadContext:   resourceDigitalOceanDropletGroupRead,
+		UpdateContext: resourceDigitalOceanDropletGroupUpdate,
+		DeleteContext: resourceDigitalOceanDropletGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanDropletGroupImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"member_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"group_tag": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: tag.ValidateTag,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(val interface{}) string {
+					// DO API V2 region slug is always lowercase
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"size": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(val interface{}) string {
+					// DO API V2 size slug is always lowercase
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"image": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"ssh_keys": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"user_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				StateFunc:    util.HashStringStateFunc(),
+			},
+			"vpc_uuid": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"ipv6": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"monitoring": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"backups": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: tag.ValidateTag,
+				},
+				Set: util.HashStringIgnoreCase,
+			},
+			"delete_by_tag": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"urn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv4_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv4_address_private": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanDropletGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	groupTag := d.Get("group_tag").(string)
+	if groupTag == "" {
+		groupTag = "droplet-group:" + d.Get("name").(string)
+		d.Set("group_tag", groupTag)
+	}
+
+	d.SetId(groupTag)
+
+	err := createDropletGroupMembers(ctx, d, meta, dropletGroupMemberIndexes(nil, d.Get("member_count").(int)), schema.TimeoutCreate)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Created droplet group %s with %d members", groupTag, d.Get("member_count").(int))
+
+	return resourceDigitalOceanDropletGroupRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDropletGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	members, err := listDropletGroupMembers(client, d.Id(), d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(members) == 0 {
+		log.Printf("[WARN] DigitalOcean droplet group (%s) has no members", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group_tag", d.Id())
+	d.Set("member_count", len(members))
+
+	// The shared attributes are taken from the first member, as all members
+	// are created from the same configuration.
+	first := members[0]
+	d.Set("region", first.Region.Slug)
+	d.Set("size", first.Size.Slug)
+	d.Set("vpc_uuid", first.VPCUUID)
+
+	if features := first.Features; features != nil {
+		d.Set("backups", containsDigitalOceanDropletFeature(features, "backups"))
+		d.Set("ipv6", containsDigitalOceanDropletFeature(features, "ipv6"))
+		d.Set("monitoring", containsDigitalOceanDropletFeature(features, "monitoring"))
+	}
+
+	tags := make([]string, 0, len(first.Tags))
+	for _, t := range first.Tags {
+		if t != d.Id() {
+			tags = append(tags, t)
+		}
+	}
+	if err := d.Set("tags", tag.FlattenTags(tags)); err != nil {
+		return diag.Errorf("Error setting `tags`: %+v", err)
+	}
+
+	if _, ok := d.GetOk("name"); !ok {
+		if name, _, ok := splitDropletGroupMemberName(first.Name); ok {
+			d.Set("name", name)
+		}
+	}
+	if _, ok := d.GetOk("image"); !ok {
+		if first.Image.Slug != "" {
+			d.Set("image", first.Image.Slug)
+		} else {
+			d.Set("image", strconv.Itoa(first.Image.ID))
+		}
+	}
+
+	if err := d.Set("members", flattenDropletGroupMembers(members)); err != nil {
+		return diag.Errorf("Error setting `members`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanDropletGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if d.HasChange("member_count") {
+		members, err := listDropletGroupMembers(client, d.Id(), d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		memberCount := d.Get("member_count").(int)
+		if memberCount > len(members) {
+			indexes := dropletGroupMemberIndexes(members, memberCount-len(members))
+			err = createDropletGroupMembers(ctx, d, meta, indexes, schema.TimeoutUpdate)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		} else if memberCount < len(members) {
+			// Members are sorted by index, so the highest indexes are removed first.
+			err = deleteDropletGroupMembers(ctx, d, meta, members[memberCount:], schema.TimeoutUpdate)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceDigitalOceanDropletGroupRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanDropletGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("delete_by_tag").(bool) {
+		err := deleteDropletGroupByTag(ctx, d, meta)
+		if err != nil {
+			return diag.Errorf("Error deleting droplet group (%s): %s", d.Id(), err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+
+	// By default, only the tracked members are deleted, rather than every
+	// Droplet with the group's tag, so that Droplets sharing the tag are left
+	// alone.
+	members := make([]godo.Droplet, 0)
+	for _, m := range d.Get("members").([]interface{}) {
+		member := m.(map[string]interface{})
+		members = append(members, godo.Droplet{
+			ID:   member["id"].(int),
+			Name: member["name"].(string),
+		})
+	}
+
+	err := deleteDropletGroupMembers(ctx, d, meta, members, schema.TimeoutDelete)
+	if err != nil {
+		return diag.Errorf("Error deleting droplet group (%s): %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanDropletGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// This is a non API attribute. So set to the default setting in the schema.
+	d.Set("delete_by_tag", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// createDropletGroupMembers creates the members with the given indexes in
+// batches, waiting for each batch to become active before creating the next.
+func createDropletGroupMembers(ctx context.Context, d *schema.ResourceData, meta interface{}, indexes []int, timeoutKey string) error {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	name := d.Get("name").(string)
+
+	opts := &godo.DropletMultiCreateRequest{
+		Region:     d.Get("region").(string),
+		Size:       d.Get("size").(string),
+		IPv6:       d.Get("ipv6").(bool),
+		Monitoring: d.Get("monitoring").(bool),
+		Backups:    d.Get("backups").(bool),
+		Tags:       append([]string{d.Id()}, tag.ExpandTags(d.Get("tags").(*schema.Set).List())...),
+	}
+
+	image := d.Get("image").(string)
+	if imageID, err := strconv.Atoi(image); err == nil {
+		// The image field is provided as an ID (number).
+		opts.Image.ID = imageID
+	} else {
+		opts.Image.Slug = image
+	}
+
+	if attr, ok := d.GetOk("user_data"); ok {
+		opts.UserData = attr.(string)
+	}
+
+	if attr, ok := d.GetOk("vpc_uuid"); ok {
+		opts.VPCUUID = attr.(string)
+	}
+
+	if v, ok := d.GetOk("ssh_keys"); ok {
+		expandedSshKeys, err := expandSshKeys(v.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+		opts.SSHKeys = expandedSshKeys
+	}
+
+	for start := 0; start < len(indexes); start += dropletGroupBatchSize {
+		end := start + dropletGroupBatchSize
+		if end > len(indexes) {
+			end = len(indexes)
+		}
+
+		opts.Names = make([]string, 0, end-start)
+		for _, i := range indexes[start:end] {
+			opts.Names = append(opts.Names, fmt.Sprintf("%s-%d", name, i))
+		}
+
+		log.Printf("[DEBUG] Droplet group create configuration: %#v", opts)
+		droplets, _, err := client.Droplets.CreateMultiple(context.Background(), opts)
+		if err != nil {
+			return fmt.Errorf("Error creating droplets %v: %s", opts.Names, err)
+		}
+
+		created := make(map[int]bool, len(droplets))
+		for _, droplet := range droplets {
+			created[droplet.ID] = true
+		}
+
+		err = waitForDropletGroupMembers(ctx, d, meta, timeoutKey, func(members []godo.Droplet) bool {
+			active := 0
+			for _, member := range members {
+				if created[member.ID] && member.Status == "active" {
+					active++
+				}
+			}
+			return active == len(created)
+		})
+		if err != nil {
+			return fmt.Errorf("Error waiting for droplets %v to become ready: %s", opts.Names, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteDropletGroupMembers(ctx context.Context, d *schema.ResourceData, meta interface{}, members []godo.Droplet, timeoutKey string) error {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	deleted := make(map[int]bool, len(members))
+	for _, member := range members {
+		log.Printf("[INFO] Deleting droplet %s (%d) from droplet group %s", member.Name, member.ID, d.Id())
+		resp, err := client.Droplets.Delete(context.Background(), member.ID)
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
+			return fmt.Errorf("Error deleting droplet %s (%d): %s", member.Name, member.ID, err)
+		}
+		deleted[member.ID] = true
+	}
+
+	err := waitForDropletGroupMembers(ctx, d, meta, timeoutKey, func(members []godo.Droplet) bool {
+		for _, member := range members {
+			if deleted[member.ID] {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("Error waiting for droplets to be deleted from droplet group (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// deleteDropletGroupByTag deletes every Droplet with the group's tag in a
+// single request, including Droplets that are not members of the group.
+func deleteDropletGroupByTag(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	log.Printf("[INFO] Deleting all droplets tagged %s", d.Id())
+	resp, err := client.Droplets.DeleteByTag(context.Background(), d.Id())
+	if err != nil && (resp == nil || resp.StatusCode != 404) {
+		return fmt.Errorf("Error deleting droplets tagged %s: %s", d.Id(), err)
+	}
+
+	err = waitForDropletGroupMembers(ctx, d, meta, schema.TimeoutDelete, func(members []godo.Droplet) bool {
+		return len(members) == 0
+	})
+	if err != nil {
+		return fmt.Errorf("Error waiting for droplets tagged %s to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// waitForDropletGroupMembers polls the members of the group until done
+// reports that they have reached the desired state.
+func waitForDropletGroupMembers(ctx context.Context, d *schema.ResourceData, meta interface{}, timeoutKey string, done func([]godo.Droplet) bool) error {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"ready"},
+		Refresh: func() (interface{}, string, error) {
+			members, err := listDropletGroupMembers(client, d.Id(), d.Get("name").(string))
+			if err != nil {
+				return nil, "", err
+			}
+
+			if done(members) {
+				return members, "ready", nil
+			}
+			return members, "pending", nil
+		},
+		Timeout:    d.Timeout(timeoutKey),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// listDropletGroupMembers returns the Droplets with the group's tag, sorted by
+// their index in the group. When the name of the group is known, Droplets
+// sharing the tag that are not named like members of the group are ignored.
+func listDropletGroupMembers(client *godo.Client, groupTag string, name string) ([]godo.Droplet, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var members []godo.Droplet
+	for {
+		droplets, resp, err := client.Droplets.ListByTag(context.Background(), groupTag, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving droplets tagged %s: %s", groupTag, err)
+		}
+
+		for _, droplet := range droplets {
+			if memberName, _, ok := splitDropletGroupMemberName(droplet.Name); name != "" && (!ok || memberName != name) {
+				log.Printf("[WARN] Ignoring droplet %s (%d) tagged %s, as it is not a member of droplet group %s", droplet.Name, droplet.ID, groupTag, name)
+				continue
+			}
+			members = append(members, droplet)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving droplets tagged %s: %s", groupTag, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	sort.SliceStable(members, func(i, j int) bool {
+		_, a, _ := splitDropletGroupMemberName(members[i].Name)
+		_, b, _ := splitDropletGroupMemberName(members[j].Name)
+		if a != b {
+			return a < b
+		}
+		return members[i].ID < members[j].ID
+	})
+
+	return members, nil
+}
+
+// dropletGroupMemberIndexes returns the lowest n indexes, starting from 1,
+// not already used by the given members.
+func dropletGroupMemberIndexes(members []godo.Droplet, n int) []int {
+	used := make(map[int]bool, len(members))
+	for _, member := range members {
+		if _, i, ok := splitDropletGroupMemberName(member.Name); ok {
+			used[i] = true
+		}
+	}
+
+	indexes := make([]int, 0, n)
+	for i := 1; len(indexes) < n; i++ {
+		if !used[i] {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes
+}
+
+// splitDropletGroupMemberName splits a member name of the form `name-index`
+// into its group name and index.
+func splitDropletGroupMemberName(memberName string) (string, int, bool) {
+	sep := strings.LastIndex(memberName, "-")
+	if sep == -1 {
+		return memberName, 0, false
+	}
+
+	i, err := strconv.Atoi(memberName[sep+1:])
+	if err != nil {
+		return memberName, 0, false
+	}
+
+	return memberName[:sep], i, true
+}
+
+func flattenDropletGroupMembers(members []godo.Droplet) []interface{} {
+	flattened := make([]interface{}, 0, len(members))
+	for i := range members {
+		member := &members[i]
+		flattened = append(flattened, map[string]interface{}{
+			"id":                   member.ID,
+			"name":                 member.Name,
+			"urn":                  member.URN(),
+			"status":               member.Status,
+			"ipv4_address":         FindIPv4AddrByType(member, "public"),
+			"ipv4_address_private": FindIPv4AddrByType(member, "private"),
+			"ipv6_address":         FindIPv6AddrByType(member, "public"),
+		})
+	}
+
+	return flattened
+}
@@ -0,0 +1,152 @@
+package droplet_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanDropletGroup_Basic(t *testing.T) {
+	name := acceptance.RandomTestName()
+	resourceName := "digitalocean_droplet_group.foobar"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDropletGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletGroupConfig(name, 12),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDropletGroupMembers(resourceName, 12),
+					resource.TestCheckResourceAttr(resourceName, "group_tag", "droplet-group:"+name),
+					resource.TestCheckResourceAttr(resourceName, "member_count", "12"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "12"),
+					resource.TestCheckResourceAttr(resourceName, "members.0.name", name+"-1"),
+					resource.TestCheckResourceAttr(resourceName, "members.11.name", name+"-12"),
+					resource.TestCheckResourceAttrSet(resourceName, "members.0.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "members.0.ipv4_address"),
+					resource.TestCheckResourceAttr(resourceName, "members.0.status", "active"),
+				),
+			},
+			// Scale down
+			{
+				Config: testAccCheckDigitalOceanDropletGroupConfig(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDropletGroupMembers(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "member_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "members.1.name", name+"-2"),
+				),
+			},
+			// Scale up
+			{
+				Config: testAccCheckDigitalOceanDropletGroupConfig(name, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDropletGroupMembers(resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "members.2.name", name+"-3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user_data", "ssh_keys"},
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDropletGroup_DeleteByTag(t *testing.T) {
+	name := acceptance.RandomTestName()
+	resourceName := "digitalocean_droplet_group.foobar"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDropletGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletGroupConfig_deleteByTag(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanDropletGroupMembers(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "delete_by_tag", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanDropletGroupMembers(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+		droplets, _, err := client.Droplets.ListByTag(context.Background(), rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+
+		if len(droplets) != expected {
+			return fmt.Errorf("Expected %d droplets tagged %s, found %d", expected, rs.Primary.ID, len(droplets))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanDropletGroupDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_droplet_group" {
+			continue
+		}
+
+		droplets, _, err := client.Droplets.ListByTag(context.Background(), rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+
+		if len(droplets) != 0 {
+			return fmt.Errorf("Droplet group %s still has %d members", rs.Primary.ID, len(droplets))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanDropletGroupConfig(name string, memberCount int) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet_group" "foobar" {
+  name         = "%s"
+  member_count = %d
+  size         = "%s"
+  image        = "%s"
+  region       = "nyc3"
+  user_data    = "foobar"
+}
+`, name, memberCount, defaultSize, defaultImage)
+}
+
+func testAccCheckDigitalOceanDropletGroupConfig_deleteByTag(name string, memberCount int) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet_group" "foobar" {
+  name          = "%s"
+  member_count  = %d
+  size          = "%s"
+  image         = "%s"
+  region        = "nyc3"
+  delete_by_tag = true
+}
+`, name, memberCount, defaultSize, defaultImage)
+}
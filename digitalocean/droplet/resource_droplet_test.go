@@ -1,6 +1,7 @@
 package droplet_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/droplet"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -293,6 +295,180 @@ func TestAccDigitalOceanDroplet_UpdateUserData(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDroplet_RebuildOnImageChange(t *testing.T) {
+	var afterCreate, afterUpdate, afterReplace godo.Droplet
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_rebuild(name, defaultImage, "foobar"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterCreate),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "image", defaultImage),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "rebuild_on_image_change", "true"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_rebuild(name, "ubuntu-24-04-x64", "foobar"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterUpdate),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "image", "ubuntu-24-04-x64"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "status", "active"),
+					testAccCheckDigitalOceanDropletNotRecreated(
+						t, &afterCreate, &afterUpdate),
+				),
+			},
+			{
+				// The rebuild keeps the Droplet's user data, so changing it
+				// still replaces the Droplet.
+				Config: testAccCheckDigitalOceanDropletConfig_rebuild(name, defaultImage, "foobar foobar"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterReplace),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "image", defaultImage),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "user_data", util.HashString("foobar foobar")),
+					testAccCheckDigitalOceanDropletRecreated(
+						t, &afterUpdate, &afterReplace),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDroplet_AutoTransferImage(t *testing.T) {
+	var droplet godo.Droplet
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_autoTransferImage(name),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.transferred", &droplet),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.transferred", "region", "sfo3"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.transferred", "image_transfer_required", "true"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.transferred", "status", "active"),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_droplet.transferred", "image", "digitalocean_droplet_snapshot.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDroplet_PowerState(t *testing.T) {
+	var afterCreate, afterUpdate godo.Droplet
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_powerState(name, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterCreate),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "power_state", "off"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "status", "off"),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_powerState(name, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterUpdate),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "power_state", "on"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "status", "active"),
+					testAccCheckDigitalOceanDropletNotRecreated(
+						t, &afterCreate, &afterUpdate),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanDropletConfig_powerState(name, "shutdown"),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &afterUpdate),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "power_state", "shutdown"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "status", "off"),
+					testAccCheckDigitalOceanDropletNotRecreated(
+						t, &afterCreate, &afterUpdate),
+				),
+			},
+			// Powering the Droplet on outside of Terraform is detected as drift.
+			{
+				PreConfig: func() {
+					client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+					action, _, err := client.DropletActions.PowerOn(context.Background(), afterCreate.ID)
+					if err != nil {
+						t.Fatalf("Error powering on droplet: %s", err)
+					}
+					if err := util.WaitForAction(client, action); err != nil {
+						t.Fatalf("Error waiting for droplet to power on: %s", err)
+					}
+				},
+				Config:             testAccCheckDigitalOceanDropletConfig_powerState(name, "shutdown"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDroplet_AntiAffinity(t *testing.T) {
+	var droplet godo.Droplet
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s"
+  size   = "%s"
+  image  = "%s"
+  region = "nyc3"
+
+  anti_affinity {
+    tag  = "%s"
+    mode = "fail"
+  }
+}
+`, name, defaultSize, defaultImage, name),
+				Check: resource.ComposeTestCheckFunc(
+					acceptance.TestAccCheckDigitalOceanDropletExists("digitalocean_droplet.foobar", &droplet),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "anti_affinity.0.tag", name),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet.foobar", "anti_affinity.0.mode", "fail"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDroplet_UpdateTags(t *testing.T) {
 	var afterCreate, afterUpdate godo.Droplet
 	name := acceptance.RandomTestName()
@@ -583,6 +759,52 @@ func TestAccDigitalOceanDroplet_WithBackupPolicy(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanDroplet_UnsupportedBackupPolicy(t *testing.T) {
+	name := acceptance.RandomTestName()
+	backupsEnabled := `backups = true`
+	backupPolicy := `  backup_policy {
+	   plan    = "weekly"
+	   weekday = "MON"
+	   hour    = 3
+	 }`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckDigitalOceanDropletConfig_ChangeBackupPolicy(name, backupsEnabled, backupPolicy),
+				ExpectError: regexp.MustCompile("backup_policy hour 3 is not supported by the weekly plan"),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanDroplet_RestoreFromBackupOnCreate(t *testing.T) {
+	name := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      acceptance.TestAccCheckDigitalOceanDropletDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name                   = "%s"
+  size                   = "%s"
+  image                  = "%s"
+  region                 = "nyc3"
+  restore_from_backup_id = 12345
+}`, name, defaultSize, defaultImage),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("restore_from_backup_id can only be set on an existing droplet"),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanDroplet_EnableAndDisableGracefulShutdown(t *testing.T) {
 	var droplet godo.Droplet
 	name := acceptance.RandomTestName()
@@ -942,6 +1164,16 @@ func testAccCheckDigitalOceanDropletRecreated(t *testing.T,
 	}
 }
 
+func testAccCheckDigitalOceanDropletNotRecreated(t *testing.T,
+	before, after *godo.Droplet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.ID != after.ID {
+			t.Fatalf("Expected droplet ID to be unchanged, but it changed from %v to %v", before.ID, after.ID)
+		}
+		return nil
+	}
+}
+
 func testAccCheckDigitalOceanDropletConfig_withID(name string, slug string) string {
 	return fmt.Sprintf(`
 data "digitalocean_image" "foobar" {
@@ -1003,6 +1235,55 @@ resource "digitalocean_droplet" "foobar" {
 `, name, defaultSize, defaultImage)
 }
 
+func testAccCheckDigitalOceanDropletConfig_rebuild(name string, image string, userData string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name                    = "%s"
+  size                    = "%s"
+  image                   = "%s"
+  region                  = "nyc3"
+  user_data               = "%s"
+  rebuild_on_image_change = true
+}
+`, name, defaultSize, image, userData)
+}
+
+func testAccCheckDigitalOceanDropletConfig_autoTransferImage(name string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name   = "%s"
+  size   = "%s"
+  image  = "%s"
+  region = "nyc3"
+}
+
+resource "digitalocean_droplet_snapshot" "foobar" {
+  droplet_id = digitalocean_droplet.foobar.id
+  name       = "%s-snapshot"
+}
+
+resource "digitalocean_droplet" "transferred" {
+  name                = "%s-transferred"
+  size                = "%s"
+  image               = digitalocean_droplet_snapshot.foobar.id
+  region              = "sfo3"
+  auto_transfer_image = true
+}
+`, name, defaultSize, defaultImage, name, name, defaultSize)
+}
+
+func testAccCheckDigitalOceanDropletConfig_powerState(name string, powerState string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_droplet" "foobar" {
+  name        = "%s"
+  size        = "%s"
+  image       = "%s"
+  region      = "nyc3"
+  power_state = "%s"
+}
+`, name, defaultSize, defaultImage, powerState)
+}
+
 func testAccCheckDigitalOceanDropletConfig_RenameAndResize(newName string) string {
 	return fmt.Sprintf(`
 resource "digitalocean_droplet" "foobar" {
@@ -144,6 +144,7 @@ func DataSourceDigitalOceanDropletAutoscale() *schema.Resource {
 					},
 				},
 			},
+			"members": membersSchema(),
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -218,6 +219,12 @@ func dataSourceDigitalOceanDropletAutoscaleRead(ctx context.Context, d *schema.R
 	d.Set("created_at", foundDropletAutoscalePool.CreatedAt.UTC().String())
 	d.Set("updated_at", foundDropletAutoscalePool.UpdatedAt.UTC().String())
 
+	members, err := listDropletAutoscaleMembers(client, foundDropletAutoscalePool.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("members", flattenMembers(members))
+
 	return nil
 }
 
@@ -265,6 +272,81 @@ func flattenTemplate(template *godo.DropletAutoscaleResourceTemplate) []map[stri
 	return result
 }
 
+func membersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"droplet_id": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "ID of the member Droplet",
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Provisioning status of the member Droplet",
+				},
+				"health_status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Health status of the member Droplet",
+				},
+				"unhealthy_reason": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Reason the member Droplet is unhealthy",
+				},
+				"current_utilization": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"memory": {
+								Type:        schema.TypeFloat,
+								Computed:    true,
+								Description: "Memory utilization",
+							},
+							"cpu": {
+								Type:        schema.TypeFloat,
+								Computed:    true,
+								Description: "CPU utilization",
+							},
+						},
+					},
+				},
+				"created_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Member create timestamp",
+				},
+				"updated_at": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Member update timestamp",
+				},
+			},
+		},
+	}
+}
+
+func flattenMembers(members []*godo.DropletAutoscaleResource) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(members))
+	for _, member := range members {
+		r := make(map[string]interface{})
+		r["droplet_id"] = int(member.DropletID)
+		r["status"] = member.Status
+		r["health_status"] = member.HealthStatus
+		r["unhealthy_reason"] = member.UnhealthyReason
+		r["current_utilization"] = flattenUtilization(member.CurrentUtilization)
+		r["created_at"] = member.CreatedAt.UTC().String()
+		r["updated_at"] = member.UpdatedAt.UTC().String()
+		result = append(result, r)
+	}
+	return result
+}
+
 func flattenUtilization(util *godo.DropletAutoscaleResourceUtilization) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, 1)
 	if util != nil {
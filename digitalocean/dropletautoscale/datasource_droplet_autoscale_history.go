@@ -0,0 +1,125 @@
+package dropletautoscale
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanDropletAutoscaleHistory() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        historyEventSchema(),
+		ResultAttributeName: "events",
+		GetRecords:          getDigitalOceanDropletAutoscaleHistory,
+		FlattenRecord:       flattenDigitalOceanDropletAutoscaleHistoryEvent,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"autoscale_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "ID of the Droplet autoscale pool",
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func historyEventSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"history_event_id": {
+			Type:        schema.TypeString,
+			Description: "ID of the scaling event",
+		},
+		"current_instance_count": {
+			Type:        schema.TypeInt,
+			Description: "Number of members before the scaling event",
+		},
+		"desired_instance_count": {
+			Type:        schema.TypeInt,
+			Description: "Number of members targeted by the scaling event",
+		},
+		"reason": {
+			Type:        schema.TypeString,
+			Description: "Reason for the scaling event",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "Status of the scaling event",
+		},
+		"error_reason": {
+			Type:        schema.TypeString,
+			Description: "Reason the scaling event failed",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "Scaling event create timestamp",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "Scaling event update timestamp",
+		},
+	}
+}
+
+func getDigitalOceanDropletAutoscaleHistory(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	poolID := extra["autoscale_pool_id"].(string)
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var eventList []interface{}
+
+	for {
+		events, resp, err := client.DropletAutoscale.ListHistory(context.Background(), poolID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving history for Droplet autoscale pool (%s): %v", poolID, err)
+		}
+
+		for _, event := range events {
+			eventList = append(eventList, event)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving history for Droplet autoscale pool (%s): %v", poolID, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return eventList, nil
+}
+
+func flattenDigitalOceanDropletAutoscaleHistoryEvent(rawEvent, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	event, ok := rawEvent.(*godo.DropletAutoscaleHistoryEvent)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to *godo.DropletAutoscaleHistoryEvent")
+	}
+
+	flattenedEvent := map[string]interface{}{
+		"history_event_id":       event.HistoryEventID,
+		"current_instance_count": int(event.CurrentInstanceCount),
+		"desired_instance_count": int(event.DesiredInstanceCount),
+		"reason":                 event.Reason,
+		"status":                 event.Status,
+		"error_reason":           event.ErrorReason,
+		"created_at":             event.CreatedAt.UTC().String(),
+		"updated_at":             event.UpdatedAt.UTC().String(),
+	}
+
+	return flattenedEvent, nil
+}
@@ -0,0 +1,48 @@
+package dropletautoscale_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanDropletAutoscaleHistory_Basic(t *testing.T) {
+	var autoscalePool godo.DropletAutoscalePool
+	name := acceptance.RandomTestName()
+
+	createConfig := testAccCheckDigitalOceanDropletAutoscaleConfig_static(name, 1)
+	dataSourceConfig := fmt.Sprintf(`%s
+
+data "digitalocean_droplet_autoscale_history" "foo" {
+  autoscale_pool_id = digitalocean_droplet_autoscale.foobar.id
+}`, createConfig)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanDropletAutoscaleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: createConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanDropletAutoscaleExists("digitalocean_droplet_autoscale.foobar", &autoscalePool),
+				),
+			},
+			{
+				Config: dataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.digitalocean_droplet_autoscale_history.foo", "autoscale_pool_id",
+						"digitalocean_droplet_autoscale.foobar", "id"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_autoscale_history.foo", "events.0.history_event_id"),
+					resource.TestCheckResourceAttr("data.digitalocean_droplet_autoscale_history.foo", "events.0.desired_instance_count", "1"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_autoscale_history.foo", "events.0.reason"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_autoscale_history.foo", "events.0.status"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_droplet_autoscale_history.foo", "events.0.created_at"),
+				),
+			},
+		},
+	})
+}
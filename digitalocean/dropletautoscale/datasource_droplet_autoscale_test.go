@@ -76,6 +76,10 @@ data "digitalocean_droplet_autoscale" "foo" {
 						"data.digitalocean_droplet_autoscale.foo", "droplet_template.0.ssh_keys.#", "2"),
 					resource.TestCheckResourceAttr(
 						"data.digitalocean_droplet_autoscale.foo", "status", "active"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_autoscale.foo", "members.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale.foo", "members.0.droplet_id"),
 					resource.TestCheckResourceAttrSet(
 						"data.digitalocean_droplet_autoscale.foo", "created_at"),
 					resource.TestCheckResourceAttrSet(
@@ -124,6 +128,10 @@ data "digitalocean_droplet_autoscale" "foo" {
 						"data.digitalocean_droplet_autoscale.foo", "droplet_template.0.ssh_keys.#", "2"),
 					resource.TestCheckResourceAttr(
 						"data.digitalocean_droplet_autoscale.foo", "status", "active"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_autoscale.foo", "members.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale.foo", "members.0.droplet_id"),
 					resource.TestCheckResourceAttrSet(
 						"data.digitalocean_droplet_autoscale.foo", "created_at"),
 					resource.TestCheckResourceAttrSet(
@@ -202,6 +210,10 @@ data "digitalocean_droplet_autoscale" "foo" {
 						"data.digitalocean_droplet_autoscale.foo", "droplet_template.0.ssh_keys.#", "2"),
 					resource.TestCheckResourceAttr(
 						"data.digitalocean_droplet_autoscale.foo", "status", "active"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_autoscale.foo", "members.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale.foo", "members.0.droplet_id"),
 					resource.TestCheckResourceAttrSet(
 						"data.digitalocean_droplet_autoscale.foo", "created_at"),
 					resource.TestCheckResourceAttrSet(
@@ -250,6 +262,10 @@ data "digitalocean_droplet_autoscale" "foo" {
 						"data.digitalocean_droplet_autoscale.foo", "droplet_template.0.ssh_keys.#", "2"),
 					resource.TestCheckResourceAttr(
 						"data.digitalocean_droplet_autoscale.foo", "status", "active"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_droplet_autoscale.foo", "members.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_droplet_autoscale.foo", "members.0.droplet_id"),
 					resource.TestCheckResourceAttrSet(
 						"data.digitalocean_droplet_autoscale.foo", "created_at"),
 					resource.TestCheckResourceAttrSet(
@@ -159,6 +159,7 @@ func ResourceDigitalOceanDropletAutoscale() *schema.Resource {
 					},
 				},
 			},
+			"members": membersSchema(),
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -275,6 +276,12 @@ func resourceDigitalOceanDropletAutoscaleRead(ctx context.Context, d *schema.Res
 	d.Set("created_at", pool.CreatedAt.UTC().String())
 	d.Set("updated_at", pool.UpdatedAt.UTC().String())
 
+	members, err := listDropletAutoscaleMembers(client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("members", flattenMembers(members))
+
 	// Persist existing image specification (id/slug) if it exists
 	if t, ok := d.GetOk("droplet_template"); ok {
 		tList := t.([]interface{})
@@ -343,26 +350,9 @@ func dropletAutoscaleRefreshFunc(client *godo.Client, poolID string) retry.State
 		if pool.Status != "active" {
 			return pool, pool.Status, nil
 		}
-		members := make([]*godo.DropletAutoscaleResource, 0)
-		opts := &godo.ListOptions{
-			Page:    1,
-			PerPage: 100,
-		}
-		// Paginate through autoscale pool members and validate status
-		for {
-			m, resp, err := client.DropletAutoscale.ListMembers(context.Background(), poolID, opts)
-			if err != nil {
-				return nil, "", fmt.Errorf("Error listing Droplet autoscale pool members: %v", err)
-			}
-			members = append(members, m...)
-			if resp.Links.IsLastPage() {
-				break
-			}
-			page, err := resp.Links.CurrentPage()
-			if err != nil {
-				break
-			}
-			opts.Page = page + 1
+		members, err := listDropletAutoscaleMembers(client, poolID)
+		if err != nil {
+			return nil, "", err
 		}
 		// Scan through the list to find a non-active provision state
 		for i := range members {
@@ -373,3 +363,28 @@ func dropletAutoscaleRefreshFunc(client *godo.Client, poolID string) retry.State
 		return members, "active", nil
 	}
 }
+
+func listDropletAutoscaleMembers(client *godo.Client, poolID string) ([]*godo.DropletAutoscaleResource, error) {
+	members := make([]*godo.DropletAutoscaleResource, 0)
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 100,
+	}
+	// Paginate through autoscale pool members
+	for {
+		m, resp, err := client.DropletAutoscale.ListMembers(context.Background(), poolID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing Droplet autoscale pool members: %v", err)
+		}
+		members = append(members, m...)
+		if resp.Links.IsLastPage() {
+			break
+		}
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			break
+		}
+		opts.Page = page + 1
+	}
+	return members, nil
+}
@@ -68,6 +68,10 @@ func TestAccDigitalOceanDropletAutoscale_Static(t *testing.T) {
 						"digitalocean_droplet_autoscale.foobar", "droplet_template.0.ssh_keys.#", "2"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet_autoscale.foobar", "status", "active"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet_autoscale.foobar", "members.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_droplet_autoscale.foobar", "members.0.droplet_id"),
 					resource.TestCheckResourceAttrSet(
 						"digitalocean_droplet_autoscale.foobar", "created_at"),
 					resource.TestCheckResourceAttrSet(
@@ -116,6 +120,10 @@ func TestAccDigitalOceanDropletAutoscale_Static(t *testing.T) {
 						"digitalocean_droplet_autoscale.foobar", "droplet_template.0.ssh_keys.#", "2"),
 					resource.TestCheckResourceAttr(
 						"digitalocean_droplet_autoscale.foobar", "status", "active"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_droplet_autoscale.foobar", "members.#", "2"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_droplet_autoscale.foobar", "members.0.droplet_id"),
 					resource.TestCheckResourceAttrSet(
 						"digitalocean_droplet_autoscale.foobar", "created_at"),
 					resource.TestCheckResourceAttrSet(
@@ -0,0 +1,121 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanFunctionsNamespace() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanFunctionsNamespaceRead,
+		Schema: map[string]*schema.Schema{
+			"namespace_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"namespace_id", "label"},
+				Description:  "The ID of the Functions namespace",
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+				ExactlyOneOf: []string{"namespace_id", "label"},
+				Description:  "The label of the Functions namespace",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region where the Functions namespace is located",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UUID of the Functions namespace",
+			},
+			"api_host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API host used to deploy and invoke functions in the namespace",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The key used to authenticate with the namespace's API host",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of when the Functions namespace was created",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of when the Functions namespace was last updated",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanFunctionsNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	var namespace *godo.FunctionsNamespace
+	if id, ok := d.GetOk("namespace_id"); ok {
+		ns, resp, err := client.Functions.GetNamespace(context.Background(), id.(string))
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				return diag.Errorf("Functions namespace %s not found", id)
+			}
+			return diag.Errorf("Error retrieving Functions namespace: %s", err)
+		}
+		namespace = ns
+	} else {
+		namespaces, _, err := client.Functions.ListNamespaces(context.Background())
+		if err != nil {
+			return diag.Errorf("Error listing Functions namespaces: %s", err)
+		}
+
+		ns, err := findFunctionsNamespaceByLabel(namespaces, d.Get("label").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Retrieve the namespace itself so that its key is also available.
+		namespace, _, err = client.Functions.GetNamespace(context.Background(), ns.Namespace)
+		if err != nil {
+			return diag.Errorf("Error retrieving Functions namespace: %s", err)
+		}
+	}
+
+	d.SetId(namespace.Namespace)
+	d.Set("namespace_id", namespace.Namespace)
+	setFunctionsNamespaceAttributes(d, namespace)
+
+	return nil
+}
+
+func findFunctionsNamespaceByLabel(namespaces []godo.FunctionsNamespace, label string) (*godo.FunctionsNamespace, error) {
+	results := make([]godo.FunctionsNamespace, 0)
+	for _, ns := range namespaces {
+		if ns.Label == label {
+			results = append(results, ns)
+		}
+	}
+	if len(results) == 1 {
+		return &results[0], nil
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no Functions namespace found with label %s", label)
+	}
+	return nil, fmt.Errorf("too many Functions namespaces found with label %s (found %d, expected 1)", label, len(results))
+}
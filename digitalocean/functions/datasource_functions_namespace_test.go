@@ -0,0 +1,46 @@
+package functions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanFunctionsNamespace_Basic(t *testing.T) {
+	label := acceptance.RandomTestName()
+	resourceConfig := fmt.Sprintf(testAccCheckDigitalOceanFunctionsNamespaceConfig_Basic, label)
+	dataSourceConfig := `
+data "digitalocean_functions_namespace" "by_id" {
+  namespace_id = digitalocean_functions_namespace.foobar.id
+}
+
+data "digitalocean_functions_namespace" "by_label" {
+  label = digitalocean_functions_namespace.foobar.label
+}`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanFunctionsNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_functions_namespace.by_id", "label", label),
+					resource.TestCheckResourceAttrPair("data.digitalocean_functions_namespace.by_id", "api_host",
+						"digitalocean_functions_namespace.foobar", "api_host"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_functions_namespace.by_label", "id",
+						"digitalocean_functions_namespace.foobar", "id"),
+					resource.TestCheckResourceAttrPair("data.digitalocean_functions_namespace.by_label", "region",
+						"digitalocean_functions_namespace.foobar", "region"),
+				),
+			},
+		},
+	})
+}
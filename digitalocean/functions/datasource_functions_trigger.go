@@ -0,0 +1,98 @@
+package functions
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanFunctionsTrigger() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanFunctionsTriggerRead,
+		Schema: map[string]*schema.Schema{
+			"namespace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of the Functions namespace the trigger belongs to",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The name of the trigger",
+			},
+			"function": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the function invoked by the trigger, including its package if any",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the trigger",
+			},
+			"is_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the trigger invokes the function",
+			},
+			"cron": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The cron expression for when the function is invoked",
+			},
+			"body": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A JSON object passed to the function as its parameters",
+			},
+			"last_run_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the trigger last invoked the function",
+			},
+			"next_run_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the trigger will next invoke the function",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of when the trigger was created",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of when the trigger was last updated",
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanFunctionsTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	namespaceID := d.Get("namespace_id").(string)
+	name := d.Get("name").(string)
+
+	trigger, resp, err := client.Functions.GetTrigger(context.Background(), namespaceID, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return diag.Errorf("Functions trigger %s not found in namespace %s", name, namespaceID)
+		}
+		return diag.Errorf("Error retrieving Functions trigger: %s", err)
+	}
+
+	d.SetId(makeFunctionsTriggerID(namespaceID, trigger.Name))
+	if err := setFunctionsTriggerAttributes(d, trigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
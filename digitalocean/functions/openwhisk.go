@@ -0,0 +1,210 @@
+package functions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// openWhiskTimeout bounds each request to a namespace's API host. It allows
+// for the upload of large zip archives, while making sure an unresponsive
+// host does not block an apply forever.
+const openWhiskTimeout = 5 * time.Minute
+
+// ErrOpenWhiskNotFound is returned when an action or package does not exist
+// in the namespace.
+var ErrOpenWhiskNotFound = errors.New("not found")
+
+// OpenWhiskClient talks to the OpenWhisk-compatible API host of a Functions
+// namespace, which is where functions (OpenWhisk actions) are deployed.
+type OpenWhiskClient struct {
+	APIHost   string
+	Namespace string
+	// Auth is the namespace's UUID and key joined with a colon.
+	Auth string
+	// HTTPClient is used to make requests. It defaults to a client that times
+	// out after openWhiskTimeout.
+	HTTPClient *http.Client
+}
+
+// NewOpenWhiskClient returns a client for the API host of a Functions
+// namespace.
+func NewOpenWhiskClient(apiHost string, namespace string, auth string) *OpenWhiskClient {
+	return &OpenWhiskClient{
+		APIHost:    apiHost,
+		Namespace:  namespace,
+		Auth:       auth,
+		HTTPClient: &http.Client{Timeout: openWhiskTimeout},
+	}
+}
+
+type OpenWhiskAction struct {
+	Name        string              `json:"name,omitempty"`
+	Namespace   string              `json:"namespace,omitempty"`
+	Version     string              `json:"version,omitempty"`
+	Exec        *OpenWhiskExec      `json:"exec,omitempty"`
+	Limits      *OpenWhiskLimits    `json:"limits,omitempty"`
+	Parameters  []OpenWhiskKeyValue `json:"parameters"`
+	Annotations []OpenWhiskKeyValue `json:"annotations"`
+}
+
+type OpenWhiskExec struct {
+	Kind   string `json:"kind"`
+	Code   string `json:"code,omitempty"`
+	Main   string `json:"main,omitempty"`
+	Binary bool   `json:"binary"`
+}
+
+type OpenWhiskLimits struct {
+	Timeout int `json:"timeout,omitempty"`
+	Memory  int `json:"memory,omitempty"`
+	Logs    int `json:"logs,omitempty"`
+}
+
+type OpenWhiskKeyValue struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+	Init  bool        `json:"init,omitempty"`
+}
+
+// GetAction retrieves an action without its code. The name may be qualified
+// with a package, e.g. `package/action`.
+func (c *OpenWhiskClient) GetAction(ctx context.Context, name string) (*OpenWhiskAction, error) {
+	action := &OpenWhiskAction{}
+	err := c.do(ctx, http.MethodGet, c.entityPath("actions", name), url.Values{"code": {"false"}}, nil, action)
+	if err != nil {
+		return nil, err
+	}
+
+	return action, nil
+}
+
+// PutAction creates an action, or overwrites it if it already exists.
+func (c *OpenWhiskClient) PutAction(ctx context.Context, name string, action *OpenWhiskAction) (*OpenWhiskAction, error) {
+	result := &OpenWhiskAction{}
+	err := c.do(ctx, http.MethodPut, c.entityPath("actions", name), url.Values{"overwrite": {"true"}}, action, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DeleteAction deletes an action.
+func (c *OpenWhiskClient) DeleteAction(ctx context.Context, name string) error {
+	return c.do(ctx, http.MethodDelete, c.entityPath("actions", name), nil, nil, nil)
+}
+
+// EnsurePackage creates a package unless it already exists. Existing packages
+// are left untouched so that their parameters are preserved.
+func (c *OpenWhiskClient) EnsurePackage(ctx context.Context, name string) error {
+	err := c.do(ctx, http.MethodGet, c.entityPath("packages", name), nil, nil, nil)
+	if !errors.Is(err, ErrOpenWhiskNotFound) {
+		return err
+	}
+
+	return c.do(ctx, http.MethodPut, c.entityPath("packages", name), nil, map[string]interface{}{}, nil)
+}
+
+// WebURL returns the URL a web action is invoked at. Actions that are not in
+// a package are part of the `default` package.
+func (c *OpenWhiskClient) WebURL(name string) string {
+	if !strings.Contains(name, "/") {
+		name = "default/" + name
+	}
+
+	return fmt.Sprintf("%s/api/v1/web/%s/%s", c.baseURL(), url.PathEscape(c.Namespace), escapePathSegments(name))
+}
+
+func (c *OpenWhiskClient) baseURL() string {
+	host := strings.TrimSuffix(c.APIHost, "/")
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+
+	return host
+}
+
+func (c *OpenWhiskClient) entityPath(collection string, name string) string {
+	return fmt.Sprintf("/api/v1/namespaces/%s/%s/%s", url.PathEscape(c.Namespace), collection, escapePathSegments(name))
+}
+
+func (c *OpenWhiskClient) do(ctx context.Context, method string, path string, query url.Values, body interface{}, result interface{}) error {
+	u := c.baseURL() + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+	if err != nil {
+		return err
+	}
+
+	user, pass, _ := strings.Cut(c.Auth, ":")
+	req.SetBasicAuth(user, pass)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: openWhiskTimeout}
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrOpenWhiskNotFound
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		if json.Unmarshal(respBody, &apiErr) == nil && apiErr.Error != "" {
+			return fmt.Errorf("%s %s: %d %s", method, path, resp.StatusCode, apiErr.Error)
+		}
+		return fmt.Errorf("%s %s: %d %s", method, path, resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+
+	if result != nil && len(respBody) > 0 {
+		return json.Unmarshal(respBody, result)
+	}
+
+	return nil
+}
+
+func escapePathSegments(name string) string {
+	segments := strings.Split(name, "/")
+	for i := range segments {
+		segments[i] = url.PathEscape(segments[i])
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,325 @@
+package functions_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/functions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// newOpenWhiskStandIn starts a minimal stand-in for a namespace's API host
+// that stores actions and packages in memory.
+func newOpenWhiskStandIn(t *testing.T) (*httptest.Server, map[string]functions.OpenWhiskAction, map[string]bool) {
+	actions := make(map[string]functions.OpenWhiskAction)
+	packages := make(map[string]bool)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/namespaces/fn-test/actions/", func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "uuid" || pass != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		name := r.URL.Path[len("/api/v1/namespaces/fn-test/actions/"):]
+		switch r.Method {
+		case http.MethodPut:
+			if r.URL.Query().Get("overwrite") != "true" {
+				t.Errorf("overwrite = %q, expected true", r.URL.Query().Get("overwrite"))
+			}
+			var action functions.OpenWhiskAction
+			if err := json.NewDecoder(r.Body).Decode(&action); err != nil {
+				t.Fatalf("error decoding action: %s", err)
+			}
+			action.Name = name
+			action.Version = "0.0.1"
+			actions[name] = action
+			json.NewEncoder(w).Encode(action)
+		case http.MethodGet:
+			if r.URL.Query().Get("code") != "false" {
+				t.Errorf("code = %q, expected false", r.URL.Query().Get("code"))
+			}
+			action, ok := actions[name]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			exec := *action.Exec
+			exec.Code = ""
+			action.Exec = &exec
+			json.NewEncoder(w).Encode(action)
+		case http.MethodDelete:
+			if _, ok := actions[name]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(actions, name)
+			w.WriteHeader(http.StatusOK)
+		}
+	})
+	mux.HandleFunc("/api/v1/namespaces/fn-test/packages/", func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Path[len("/api/v1/namespaces/fn-test/packages/"):]
+		switch r.Method {
+		case http.MethodPut:
+			if packages[name] {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			packages[name] = true
+			w.Write([]byte(`{}`))
+		case http.MethodGet:
+			if !packages[name] {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write([]byte(`{}`))
+		}
+	})
+
+	// The namespace is served too, so that the function resource can be
+	// pointed at the stand-in through a godo client.
+	mux.HandleFunc("/v2/functions/namespaces/ns-test", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"namespace": map[string]interface{}{
+				"api_host":  "http://" + r.Host,
+				"namespace": "fn-test",
+				"uuid":      "uuid",
+				"key":       "key",
+			},
+		})
+	})
+
+	mux.HandleFunc("/v2/functions/namespaces/ns-no-key", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"namespace": map[string]interface{}{
+				"api_host":  "http://" + r.Host,
+				"namespace": "fn-test",
+				"uuid":      "uuid",
+			},
+		})
+	})
+
+	return httptest.NewServer(mux), actions, packages
+}
+
+func TestOpenWhiskClient_Actions(t *testing.T) {
+	server, actions, packages := newOpenWhiskStandIn(t)
+	defer server.Close()
+
+	client := &functions.OpenWhiskClient{
+		APIHost:   server.URL,
+		Namespace: "fn-test",
+		Auth:      "uuid:key",
+	}
+	ctx := context.Background()
+
+	if err := client.EnsurePackage(ctx, "jobs"); err != nil {
+		t.Fatalf("error creating package: %s", err)
+	}
+	// The package already exists, so it must not be created again.
+	if err := client.EnsurePackage(ctx, "jobs"); err != nil {
+		t.Fatalf("error ensuring existing package: %s", err)
+	}
+	if !packages["jobs"] {
+		t.Errorf("package jobs was not created")
+	}
+
+	_, err := client.PutAction(ctx, "jobs/cleanup", &functions.OpenWhiskAction{
+		Exec: &functions.OpenWhiskExec{
+			Kind: "nodejs:18",
+			Code: "function main() { return {} }",
+		},
+		Parameters: []functions.OpenWhiskKeyValue{
+			{Key: "LOG_LEVEL", Value: "debug", Init: true},
+		},
+		Annotations: []functions.OpenWhiskKeyValue{
+			{Key: "web-export", Value: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("error putting action: %s", err)
+	}
+	if actions["jobs/cleanup"].Exec.Code == "" {
+		t.Errorf("action code was not uploaded")
+	}
+
+	action, err := client.GetAction(ctx, "jobs/cleanup")
+	if err != nil {
+		t.Fatalf("error getting action: %s", err)
+	}
+	if action.Exec.Kind != "nodejs:18" {
+		t.Errorf("kind = %v, expected nodejs:18", action.Exec.Kind)
+	}
+	if action.Version != "0.0.1" {
+		t.Errorf("version = %v, expected 0.0.1", action.Version)
+	}
+	if len(action.Parameters) != 1 || !action.Parameters[0].Init {
+		t.Errorf("parameters = %v, expected a single init parameter", action.Parameters)
+	}
+
+	if err := client.DeleteAction(ctx, "jobs/cleanup"); err != nil {
+		t.Fatalf("error deleting action: %s", err)
+	}
+
+	_, err = client.GetAction(ctx, "jobs/cleanup")
+	if !errors.Is(err, functions.ErrOpenWhiskNotFound) {
+		t.Errorf("error = %v, expected %v", err, functions.ErrOpenWhiskNotFound)
+	}
+}
+
+func TestOpenWhiskClient_Unauthorized(t *testing.T) {
+	server, _, _ := newOpenWhiskStandIn(t)
+	defer server.Close()
+
+	client := &functions.OpenWhiskClient{
+		APIHost:   server.URL,
+		Namespace: "fn-test",
+		Auth:      "uuid:wrong",
+	}
+
+	_, err := client.GetAction(context.Background(), "cleanup")
+	if err == nil || errors.Is(err, functions.ErrOpenWhiskNotFound) {
+		t.Errorf("error = %v, expected an authorization error", err)
+	}
+}
+
+func TestOpenWhiskClient_WebURL(t *testing.T) {
+	client := &functions.OpenWhiskClient{
+		APIHost:   "faas-nyc1-2ef2e6cc.doserverless.co",
+		Namespace: "fn-test",
+	}
+
+	cases := map[string]string{
+		"hello":      "https://faas-nyc1-2ef2e6cc.doserverless.co/api/v1/web/fn-test/default/hello",
+		"jobs/hello": "https://faas-nyc1-2ef2e6cc.doserverless.co/api/v1/web/fn-test/jobs/hello",
+	}
+	for name, expected := range cases {
+		if url := client.WebURL(name); url != expected {
+			t.Errorf("WebURL(%q) = %v, expected %v", name, url, expected)
+		}
+	}
+}
+
+func TestResourceDigitalOceanFunction_StandIn(t *testing.T) {
+	server, actions, packages := newOpenWhiskStandIn(t)
+	defer server.Close()
+
+	cfg := &config.Config{
+		Token:             "token",
+		APIEndpoint:       server.URL,
+		SpacesAPIEndpoint: "https://{{.Region}}.digitaloceanspaces.com",
+	}
+	meta, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error configuring client: %s", err)
+	}
+
+	sourceFile := filepath.Join(t.TempDir(), "cleanup.js")
+	source := "function main() { return {} }"
+	if err := os.WriteFile(sourceFile, []byte(source), 0644); err != nil {
+		t.Fatalf("error writing function source: %s", err)
+	}
+
+	r := functions.ResourceDigitalOceanFunction()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"namespace_id": "ns-test",
+		"package":      "jobs",
+		"name":         "cleanup",
+		"runtime":      "nodejs:18",
+		"source_file":  sourceFile,
+		"web":          true,
+		"environment": map[string]interface{}{
+			"LOG_LEVEL": "debug",
+		},
+	})
+	ctx := context.Background()
+
+	if diags := r.CreateContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("error creating function: %v", diags)
+	}
+	if d.Id() != "ns-test/function/jobs/cleanup" {
+		t.Errorf("id = %v, expected ns-test/function/jobs/cleanup", d.Id())
+	}
+	if !packages["jobs"] {
+		t.Errorf("package jobs was not created")
+	}
+	if code := actions["jobs/cleanup"].Exec.Code; code != source {
+		t.Errorf("code = %q, expected %q", code, source)
+	}
+	if v := d.Get("version").(string); v != "0.0.1" {
+		t.Errorf("version = %v, expected 0.0.1", v)
+	}
+	if v := d.Get("url").(string); v != server.URL+"/api/v1/web/fn-test/jobs/cleanup" {
+		t.Errorf("url = %v, expected %v", v, server.URL+"/api/v1/web/fn-test/jobs/cleanup")
+	}
+	if v := d.Get("environment.LOG_LEVEL").(string); v != "debug" {
+		t.Errorf("environment.LOG_LEVEL = %v, expected debug", v)
+	}
+	if v := d.Get("source_hash").(string); v == "" {
+		t.Errorf("source_hash is not set")
+	}
+
+	if diags := r.DeleteContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("error deleting function: %v", diags)
+	}
+	if _, ok := actions["jobs/cleanup"]; ok {
+		t.Errorf("action jobs/cleanup was not deleted")
+	}
+
+	// Reading a function that no longer exists removes it from the state.
+	d.SetId("ns-test/function/jobs/cleanup")
+	if diags := r.ReadContext(ctx, d, meta); diags.HasError() {
+		t.Fatalf("error reading function: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("id = %v, expected the function to be removed", d.Id())
+	}
+}
+
+func TestResourceDigitalOceanFunction_StandInWithoutKey(t *testing.T) {
+	server, actions, _ := newOpenWhiskStandIn(t)
+	defer server.Close()
+
+	cfg := &config.Config{
+		Token:             "token",
+		APIEndpoint:       server.URL,
+		SpacesAPIEndpoint: "https://{{.Region}}.digitaloceanspaces.com",
+	}
+	meta, err := cfg.Client()
+	if err != nil {
+		t.Fatalf("error configuring client: %s", err)
+	}
+
+	sourceFile := filepath.Join(t.TempDir(), "cleanup.js")
+	if err := os.WriteFile(sourceFile, []byte("function main() { return {} }"), 0644); err != nil {
+		t.Fatalf("error writing function source: %s", err)
+	}
+
+	r := functions.ResourceDigitalOceanFunction()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"namespace_id": "ns-no-key",
+		"name":         "cleanup",
+		"runtime":      "nodejs:18",
+		"source_file":  sourceFile,
+	})
+
+	diags := r.CreateContext(context.Background(), d, meta)
+	if !diags.HasError() {
+		t.Fatalf("expected an error creating a function in a namespace without a key")
+	}
+	if summary := diags[0].Summary; !strings.Contains(summary, "did not return the key") {
+		t.Errorf("error = %q, expected it to mention the missing key", summary)
+	}
+	if len(actions) != 0 {
+		t.Errorf("actions were deployed without a key: %v", actions)
+	}
+}
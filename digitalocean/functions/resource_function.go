@@ -0,0 +1,474 @@
+package functions
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	functionAnnotationWebExport   = "web-export"
+	functionAnnotationRawHTTP     = "raw-http"
+	functionAnnotationFinal       = "final"
+	functionAnnotationRequireAuth = "require-whisk-auth"
+)
+
+func ResourceDigitalOceanFunction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanFunctionCreate,
+		ReadContext:   resourceDigitalOceanFunctionRead,
+		UpdateContext: resourceDigitalOceanFunctionUpdate,
+		DeleteContext: resourceDigitalOceanFunctionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDigitalOceanFunctionImport,
+		},
+		CustomizeDiff: resourceDigitalOceanFunctionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"namespace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of the Functions namespace the function is deployed to",
+			},
+			"package": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringDoesNotContainAny("/"),
+				Description:  "The package the function belongs to",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.All(validation.NoZeroValues, validation.StringDoesNotContainAny("/")),
+				Description:  "The name of the function",
+			},
+			"runtime": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The runtime of the function, e.g. nodejs:18",
+			},
+			"main": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the function's entry point",
+			},
+			"source_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"source_file", "source_dir"},
+				Description:  "Path to a source file or zip archive containing the function's code",
+			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"source_file", "source_dir"},
+				Description:  "Path to a directory containing the function's code, which is zipped before it is deployed",
+			},
+			"source_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA256 hash of the deployed code",
+			},
+			"limits": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum duration of an invocation in milliseconds",
+						},
+						"memory": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum memory of an invocation in megabytes",
+						},
+						"logs": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The maximum size of an invocation's logs in kilobytes",
+						},
+					},
+				},
+			},
+			"environment": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Environment variables available to the function",
+			},
+			"web": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the function is exposed as a web function",
+			},
+			"raw_http": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether a web function receives the raw HTTP request",
+			},
+			"web_secure": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "A secret that must be sent in the X-Require-Whisk-Auth header to invoke a web function",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the deployed function",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of a web function",
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanFunctionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_file") || !d.NewValueKnown("source_dir") {
+		return d.SetNewComputed("source_hash")
+	}
+
+	_, _, hash, err := readFunctionSource(d.Get("source_file").(string), d.Get("source_dir").(string))
+	if err != nil {
+		return err
+	}
+
+	if hash != d.Get("source_hash").(string) {
+		return d.SetNew("source_hash", hash)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanFunctionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	namespaceID := d.Get("namespace_id").(string)
+	name := functionQualifiedName(d.Get("package").(string), d.Get("name").(string))
+
+	if err := deployFunction(ctx, d, meta); err != nil {
+		return diag.Errorf("Error deploying function %s: %s", name, err)
+	}
+
+	d.SetId(makeFunctionID(namespaceID, name))
+	log.Printf("[INFO] Function deployed, ID: %s", d.Id())
+
+	return resourceDigitalOceanFunctionRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanFunctionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	whisk, err := openWhiskClientForNamespace(meta, d.Get("namespace_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := functionQualifiedName(d.Get("package").(string), d.Get("name").(string))
+	action, err := whisk.GetAction(ctx, name)
+	if err != nil {
+		if errors.Is(err, ErrOpenWhiskNotFound) {
+			log.Printf("[WARN] Function (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving function %s: %s", name, err)
+	}
+
+	d.Set("version", action.Version)
+	if action.Exec != nil {
+		d.Set("runtime", action.Exec.Kind)
+		d.Set("main", action.Exec.Main)
+	}
+	if action.Limits != nil {
+		d.Set("limits", []map[string]interface{}{{
+			"timeout": action.Limits.Timeout,
+			"memory":  action.Limits.Memory,
+			"logs":    action.Limits.Logs,
+		}})
+	}
+
+	environment := make(map[string]interface{})
+	for _, p := range action.Parameters {
+		if s, ok := p.Value.(string); ok && p.Init {
+			environment[p.Key] = s
+		}
+	}
+	d.Set("environment", environment)
+
+	web, rawHTTP, webSecure := false, false, ""
+	for _, a := range action.Annotations {
+		switch a.Key {
+		case functionAnnotationWebExport:
+			web, _ = a.Value.(bool)
+		case functionAnnotationRawHTTP:
+			rawHTTP, _ = a.Value.(bool)
+		case functionAnnotationRequireAuth:
+			webSecure, _ = a.Value.(string)
+		}
+	}
+	d.Set("web", web)
+	d.Set("raw_http", rawHTTP)
+	d.Set("web_secure", webSecure)
+
+	if web {
+		d.Set("url", whisk.WebURL(name))
+	} else {
+		d.Set("url", "")
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := functionQualifiedName(d.Get("package").(string), d.Get("name").(string))
+
+	// The action is replaced as a whole, so every change results in it being redeployed.
+	if err := deployFunction(ctx, d, meta); err != nil {
+		return diag.Errorf("Error deploying function %s: %s", name, err)
+	}
+
+	return resourceDigitalOceanFunctionRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanFunctionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	whisk, err := openWhiskClientForNamespace(meta, d.Get("namespace_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The package is left in place as it may contain other functions.
+	name := functionQualifiedName(d.Get("package").(string), d.Get("name").(string))
+	log.Printf("[INFO] Deleting function: %s", d.Id())
+	err = whisk.DeleteAction(ctx, name)
+	if err != nil && !errors.Is(err, ErrOpenWhiskNotFound) {
+		return diag.Errorf("Error deleting function %s: %s", name, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanFunctionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+		pkg, name := "", s[1]
+		if i := strings.Index(name, "/"); i >= 0 {
+			pkg, name = name[:i], name[i+1:]
+		}
+
+		d.SetId(makeFunctionID(s[0], s[1]))
+		d.Set("namespace_id", s[0])
+		d.Set("package", pkg)
+		d.Set("name", name)
+	} else {
+		return nil, errors.New("must use the ID of the Functions namespace and the name of the function joined with a comma (e.g. `id,package/name`)")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func makeFunctionID(namespaceID string, name string) string {
+	return fmt.Sprintf("%s/function/%s", namespaceID, name)
+}
+
+func functionQualifiedName(pkg string, name string) string {
+	if pkg == "" {
+		return name
+	}
+
+	return pkg + "/" + name
+}
+
+func openWhiskClientForNamespace(meta interface{}, namespaceID string) (*OpenWhiskClient, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	namespace, _, err := client.Functions.GetNamespace(context.Background(), namespaceID)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Functions namespace %s: %s", namespaceID, err)
+	}
+
+	// The key is only guaranteed to be returned when the namespace is created,
+	// and the API host rejects requests without it.
+	if namespace.Key == "" {
+		return nil, fmt.Errorf("Error retrieving Functions namespace %s: the API did not return the key needed to deploy functions to it", namespaceID)
+	}
+
+	// The provider's HTTP client is not reused, as it would send the API token
+	// to the namespace's API host.
+	return NewOpenWhiskClient(namespace.ApiHost, namespace.Namespace, namespace.UUID+":"+namespace.Key), nil
+}
+
+func deployFunction(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	whisk, err := openWhiskClientForNamespace(meta, d.Get("namespace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	code, binary, hash, err := readFunctionSource(d.Get("source_file").(string), d.Get("source_dir").(string))
+	if err != nil {
+		return err
+	}
+
+	action := &OpenWhiskAction{
+		Exec: &OpenWhiskExec{
+			Kind:   d.Get("runtime").(string),
+			Code:   code,
+			Main:   d.Get("main").(string),
+			Binary: binary,
+		},
+		Parameters: []OpenWhiskKeyValue{},
+		Annotations: []OpenWhiskKeyValue{
+			{Key: functionAnnotationWebExport, Value: d.Get("web").(bool)},
+			{Key: functionAnnotationRawHTTP, Value: d.Get("raw_http").(bool)},
+			{Key: functionAnnotationFinal, Value: true},
+		},
+	}
+
+	if v, ok := d.GetOk("limits"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		limits := v.([]interface{})[0].(map[string]interface{})
+		action.Limits = &OpenWhiskLimits{
+			Timeout: limits["timeout"].(int),
+			Memory:  limits["memory"].(int),
+			Logs:    limits["logs"].(int),
+		}
+	}
+
+	for k, v := range d.Get("environment").(map[string]interface{}) {
+		action.Parameters = append(action.Parameters, OpenWhiskKeyValue{Key: k, Value: v.(string), Init: true})
+	}
+
+	if v, ok := d.GetOk("web_secure"); ok {
+		action.Annotations = append(action.Annotations, OpenWhiskKeyValue{Key: functionAnnotationRequireAuth, Value: v.(string)})
+	}
+
+	pkg := d.Get("package").(string)
+	if pkg != "" {
+		if err := whisk.EnsurePackage(ctx, pkg); err != nil {
+			return fmt.Errorf("Error creating package %s: %s", pkg, err)
+		}
+	}
+
+	if _, err := whisk.PutAction(ctx, functionQualifiedName(pkg, d.Get("name").(string)), action); err != nil {
+		return err
+	}
+
+	d.Set("source_hash", hash)
+	return nil
+}
+
+// readFunctionSource returns the code to deploy for a function, whether it is
+// a binary (base64 encoded zip archive) and the SHA256 hash of its content.
+func readFunctionSource(sourceFile string, sourceDir string) (string, bool, string, error) {
+	var (
+		content []byte
+		binary  bool
+		err     error
+	)
+
+	if sourceDir != "" {
+		content, err = zipFunctionSourceDir(sourceDir)
+		if err != nil {
+			return "", false, "", fmt.Errorf("Error zipping %s: %s", sourceDir, err)
+		}
+		binary = true
+	} else {
+		content, err = os.ReadFile(sourceFile)
+		if err != nil {
+			return "", false, "", fmt.Errorf("Error reading %s: %s", sourceFile, err)
+		}
+		binary = strings.EqualFold(filepath.Ext(sourceFile), ".zip")
+	}
+
+	sum := sha256.Sum256(content)
+	hash := hex.EncodeToString(sum[:])
+
+	if binary {
+		return base64.StdEncoding.EncodeToString(content), true, hash, nil
+	}
+
+	return string(content), false, hash, nil
+}
+
+// zipFunctionSourceDir zips a directory. Modification times are fixed so that
+// the archive, and therefore its hash, only changes when the files do.
+func zipFunctionSourceDir(dir string) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+
+	modified := time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		f, err := w.CreateHeader(&zip.FileHeader{
+			Name:     filepath.ToSlash(rel),
+			Method:   zip.Deflate,
+			Modified: modified,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = f.Write(content)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,82 @@
+package functions_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanFunction_Basic(t *testing.T) {
+	label := acceptance.RandomTestName()
+	sourceFile := filepath.Join(t.TempDir(), "hello.js")
+	writeFunctionSource(t, sourceFile, "Hello")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanFunctionsNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanFunctionConfig_basic(label, sourceFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_function.foobar", "package", "tf-acc"),
+					resource.TestCheckResourceAttr("digitalocean_function.foobar", "name", "hello"),
+					resource.TestCheckResourceAttr("digitalocean_function.foobar", "runtime", "nodejs:18"),
+					resource.TestCheckResourceAttr("digitalocean_function.foobar", "limits.0.timeout", "3000"),
+					resource.TestCheckResourceAttr("digitalocean_function.foobar", "limits.0.memory", "256"),
+					resource.TestCheckResourceAttr("digitalocean_function.foobar", "web", "true"),
+					resource.TestCheckResourceAttrSet("digitalocean_function.foobar", "source_hash"),
+					resource.TestCheckResourceAttrSet("digitalocean_function.foobar", "version"),
+					resource.TestCheckResourceAttrSet("digitalocean_function.foobar", "url"),
+				),
+			},
+			{
+				// Changing the source redeploys the function
+				PreConfig: func() { writeFunctionSource(t, sourceFile, "Goodbye") },
+				Config:    testAccCheckDigitalOceanFunctionConfig_basic(label, sourceFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_function.foobar", "name", "hello"),
+					resource.TestCheckResourceAttrSet("digitalocean_function.foobar", "source_hash"),
+				),
+			},
+		},
+	})
+}
+
+func writeFunctionSource(t *testing.T, path string, greeting string) {
+	source := fmt.Sprintf("function main(args) {\n  return { body: '%s, ' + (args.name || 'world') }\n}\n", greeting)
+	if err := os.WriteFile(path, []byte(source), 0644); err != nil {
+		t.Fatalf("error writing function source: %s", err)
+	}
+}
+
+func testAccCheckDigitalOceanFunctionConfig_basic(label string, sourceFile string) string {
+	return fmt.Sprintf(`
+resource "digitalocean_functions_namespace" "foobar" {
+  label  = "%s"
+  region = "nyc1"
+}
+
+resource "digitalocean_function" "foobar" {
+  namespace_id = digitalocean_functions_namespace.foobar.id
+  package      = "tf-acc"
+  name         = "hello"
+  runtime      = "nodejs:18"
+  source_file  = "%s"
+  web          = true
+
+  limits {
+    timeout = 3000
+    memory  = 256
+  }
+
+  environment = {
+    GREETING_STYLE = "friendly"
+  }
+}
+`, label, sourceFile)
+}
@@ -0,0 +1,146 @@
+package functions
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanFunctionsNamespace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanFunctionsNamespaceCreate,
+		ReadContext:   resourceDigitalOceanFunctionsNamespaceRead,
+		DeleteContext: resourceDigitalOceanFunctionsNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The label of the Functions namespace",
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(val interface{}) string {
+					// DO API V2 region slug is always lowercase
+					return strings.ToLower(val.(string))
+				},
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The region where the Functions namespace is located",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UUID of the Functions namespace",
+			},
+			"api_host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API host used to deploy and invoke functions in the namespace",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The key used to authenticate with the namespace's API host",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of when the Functions namespace was created",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of when the Functions namespace was last updated",
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanFunctionsNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &godo.FunctionsNamespaceCreateRequest{
+		Label:  d.Get("label").(string),
+		Region: d.Get("region").(string),
+	}
+
+	log.Printf("[DEBUG] Functions namespace create configuration: %#v", opts)
+	namespace, _, err := client.Functions.CreateNamespace(context.Background(), opts)
+	if err != nil {
+		return diag.Errorf("Error creating Functions namespace: %s", err)
+	}
+
+	d.SetId(namespace.Namespace)
+	log.Printf("[INFO] Functions namespace created, ID: %s", d.Id())
+
+	// The key is only guaranteed to be returned when the namespace is created.
+	d.Set("key", namespace.Key)
+
+	return resourceDigitalOceanFunctionsNamespaceRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanFunctionsNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	namespace, resp, err := client.Functions.GetNamespace(context.Background(), d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Functions namespace (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving Functions namespace: %s", err)
+	}
+
+	setFunctionsNamespaceAttributes(d, namespace)
+
+	return nil
+}
+
+func resourceDigitalOceanFunctionsNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	log.Printf("[INFO] Deleting Functions namespace: %s", d.Id())
+	resp, err := client.Functions.DeleteNamespace(context.Background(), d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error deleting Functions namespace: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func setFunctionsNamespaceAttributes(d *schema.ResourceData, namespace *godo.FunctionsNamespace) {
+	d.Set("label", namespace.Label)
+	d.Set("region", namespace.Region)
+	d.Set("uuid", namespace.UUID)
+	d.Set("api_host", namespace.ApiHost)
+	d.Set("created_at", namespace.CreatedAt.UTC().String())
+	d.Set("updated_at", namespace.UpdatedAt.UTC().String())
+
+	// Keep the key from creation if the API omits it when the namespace is retrieved.
+	if namespace.Key != "" {
+		d.Set("key", namespace.Key)
+	}
+}
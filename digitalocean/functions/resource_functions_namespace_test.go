@@ -0,0 +1,98 @@
+package functions_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const testAccCheckDigitalOceanFunctionsNamespaceConfig_Basic = `
+resource "digitalocean_functions_namespace" "foobar" {
+  label  = "%s"
+  region = "nyc1"
+}
+`
+
+func TestAccDigitalOceanFunctionsNamespace_Basic(t *testing.T) {
+	label := acceptance.RandomTestName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanFunctionsNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccCheckDigitalOceanFunctionsNamespaceConfig_Basic, label),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanFunctionsNamespaceExists("digitalocean_functions_namespace.foobar"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_functions_namespace.foobar", "label", label),
+					resource.TestCheckResourceAttr(
+						"digitalocean_functions_namespace.foobar", "region", "nyc1"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_functions_namespace.foobar", "uuid"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_functions_namespace.foobar", "api_host"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_functions_namespace.foobar", "key"),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_functions_namespace.foobar", "created_at"),
+				),
+			},
+			{
+				ResourceName:            "digitalocean_functions_namespace.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key"},
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanFunctionsNamespaceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Functions namespace ID is set")
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		namespace, _, err := client.Functions.GetNamespace(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if namespace.Namespace != rs.Primary.ID {
+			return fmt.Errorf("Functions namespace not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanFunctionsNamespaceDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_functions_namespace" {
+			continue
+		}
+
+		_, _, err := client.Functions.GetNamespace(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Functions namespace still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const functionsTriggerTypeScheduled = "SCHEDULED"
+
+func ResourceDigitalOceanFunctionsTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanFunctionsTriggerCreate,
+		ReadContext:   resourceDigitalOceanFunctionsTriggerRead,
+		UpdateContext: resourceDigitalOceanFunctionsTriggerUpdate,
+		DeleteContext: resourceDigitalOceanFunctionsTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDigitalOceanFunctionsTriggerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"namespace_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The ID of the Functions namespace the trigger belongs to",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The name of the trigger",
+			},
+			"function": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The name of the function invoked by the trigger, including its package if any",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      functionsTriggerTypeScheduled,
+				ValidateFunc: validation.StringInSlice([]string{functionsTriggerTypeScheduled}, false),
+				Description:  "The type of the trigger",
+			},
+			"is_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the trigger invokes the function",
+			},
+			"cron": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The cron expression for when the function is invoked",
+			},
+			"body": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				Description: "A JSON object passed to the function as its parameters",
+			},
+			"last_run_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the trigger last invoked the function",
+			},
+			"next_run_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the trigger will next invoke the function",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of when the trigger was created",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time of when the trigger was last updated",
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanFunctionsTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	namespaceID := d.Get("namespace_id").(string)
+
+	details, err := expandFunctionsTriggerScheduledDetails(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := &godo.FunctionsTriggerCreateRequest{
+		Name:             d.Get("name").(string),
+		Type:             d.Get("type").(string),
+		Function:         d.Get("function").(string),
+		IsEnabled:        d.Get("is_enabled").(bool),
+		ScheduledDetails: details,
+	}
+
+	log.Printf("[DEBUG] Functions trigger create configuration: %#v", opts)
+	trigger, _, err := client.Functions.CreateTrigger(context.Background(), namespaceID, opts)
+	if err != nil {
+		return diag.Errorf("Error creating Functions trigger: %s", err)
+	}
+
+	d.SetId(makeFunctionsTriggerID(namespaceID, trigger.Name))
+	log.Printf("[INFO] Functions trigger created, ID: %s", d.Id())
+
+	return resourceDigitalOceanFunctionsTriggerRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanFunctionsTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	namespaceID := d.Get("namespace_id").(string)
+	name := d.Get("name").(string)
+
+	trigger, resp, err := client.Functions.GetTrigger(context.Background(), namespaceID, name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Functions trigger (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving Functions trigger: %s", err)
+	}
+
+	if err := setFunctionsTriggerAttributes(d, trigger); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanFunctionsTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &godo.FunctionsTriggerUpdateRequest{
+		IsEnabled: godo.PtrTo(d.Get("is_enabled").(bool)),
+	}
+
+	if d.HasChanges("cron", "body") {
+		details, err := expandFunctionsTriggerScheduledDetails(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		opts.ScheduledDetails = details
+	}
+
+	_, _, err := client.Functions.UpdateTrigger(context.Background(), d.Get("namespace_id").(string), d.Get("name").(string), opts)
+	if err != nil {
+		return diag.Errorf("Error updating Functions trigger: %s", err)
+	}
+
+	return resourceDigitalOceanFunctionsTriggerRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanFunctionsTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	log.Printf("[INFO] Deleting Functions trigger: %s", d.Id())
+	resp, err := client.Functions.DeleteTrigger(context.Background(), d.Get("namespace_id").(string), d.Get("name").(string))
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error deleting Functions trigger: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanFunctionsTriggerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+		d.SetId(makeFunctionsTriggerID(s[0], s[1]))
+		d.Set("namespace_id", s[0])
+		d.Set("name", s[1])
+	} else {
+		return nil, errors.New("must use the ID of the Functions namespace and the name of the trigger joined with a comma (e.g. `id,name`)")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func makeFunctionsTriggerID(namespaceID string, name string) string {
+	return fmt.Sprintf("%s/trigger/%s", namespaceID, name)
+}
+
+func expandFunctionsTriggerScheduledDetails(d *schema.ResourceData) (*godo.TriggerScheduledDetails, error) {
+	details := &godo.TriggerScheduledDetails{
+		Cron: d.Get("cron").(string),
+	}
+
+	if v, ok := d.GetOk("body"); ok {
+		var body map[string]interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &body); err != nil {
+			return nil, fmt.Errorf("Error parsing trigger body: %s", err)
+		}
+		details.Body = body
+	}
+
+	return details, nil
+}
+
+func setFunctionsTriggerAttributes(d *schema.ResourceData, trigger *godo.FunctionsTrigger) error {
+	d.Set("name", trigger.Name)
+	d.Set("function", trigger.Function)
+	d.Set("type", trigger.Type)
+	d.Set("is_enabled", trigger.IsEnabled)
+	d.Set("created_at", trigger.CreatedAt.UTC().String())
+	d.Set("updated_at", trigger.UpdatedAt.UTC().String())
+
+	if trigger.ScheduledDetails != nil {
+		d.Set("cron", trigger.ScheduledDetails.Cron)
+
+		body := ""
+		if len(trigger.ScheduledDetails.Body) > 0 {
+			b, err := json.Marshal(trigger.ScheduledDetails.Body)
+			if err != nil {
+				return fmt.Errorf("Error serializing trigger body: %s", err)
+			}
+			body = string(b)
+		}
+		d.Set("body", body)
+	}
+
+	if trigger.ScheduledRuns != nil {
+		if !trigger.ScheduledRuns.LastRunAt.IsZero() {
+			d.Set("last_run_at", trigger.ScheduledRuns.LastRunAt.UTC().String())
+		}
+		if !trigger.ScheduledRuns.NextRunAt.IsZero() {
+			d.Set("next_run_at", trigger.ScheduledRuns.NextRunAt.UTC().String())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package functions_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanFunctionsTrigger_Basic(t *testing.T) {
+	label := acceptance.RandomTestName()
+	namespaceConfig := fmt.Sprintf(testAccCheckDigitalOceanFunctionsNamespaceConfig_Basic, label)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanFunctionsNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Triggers can only invoke functions that exist, so one is
+				// deployed to the namespace's API host first.
+				Config: namespaceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDeployDigitalOceanFunctionsAction("digitalocean_functions_namespace.foobar", "hello"),
+				),
+			},
+			{
+				Config: namespaceConfig + testAccCheckDigitalOceanFunctionsTriggerConfig_basic("*/5 * * * *", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_functions_trigger.foobar", "name", "every-five-minutes"),
+					resource.TestCheckResourceAttr("digitalocean_functions_trigger.foobar", "function", "hello"),
+					resource.TestCheckResourceAttr("digitalocean_functions_trigger.foobar", "type", "SCHEDULED"),
+					resource.TestCheckResourceAttr("digitalocean_functions_trigger.foobar", "cron", "*/5 * * * *"),
+					resource.TestCheckResourceAttr("digitalocean_functions_trigger.foobar", "is_enabled", "true"),
+					resource.TestCheckResourceAttr("digitalocean_functions_trigger.foobar", "body", `{"name":"trigger"}`),
+					resource.TestCheckResourceAttrSet("digitalocean_functions_trigger.foobar", "created_at"),
+				),
+			},
+			{
+				Config: namespaceConfig + testAccCheckDigitalOceanFunctionsTriggerConfig_basic("0 3 * * *", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_functions_trigger.foobar", "cron", "0 3 * * *"),
+					resource.TestCheckResourceAttr("digitalocean_functions_trigger.foobar", "is_enabled", "false"),
+				),
+			},
+			{
+				Config: namespaceConfig + testAccCheckDigitalOceanFunctionsTriggerConfig_basic("0 3 * * *", false) + `
+data "digitalocean_functions_trigger" "foobar" {
+  namespace_id = digitalocean_functions_trigger.foobar.namespace_id
+  name         = digitalocean_functions_trigger.foobar.name
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_functions_trigger.foobar", "function", "hello"),
+					resource.TestCheckResourceAttr("data.digitalocean_functions_trigger.foobar", "cron", "0 3 * * *"),
+					resource.TestCheckResourceAttr("data.digitalocean_functions_trigger.foobar", "is_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "digitalocean_functions_trigger.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["digitalocean_functions_trigger.foobar"]
+					if !ok {
+						return "", fmt.Errorf("Not found: digitalocean_functions_trigger.foobar")
+					}
+					return fmt.Sprintf("%s,%s", rs.Primary.Attributes["namespace_id"], rs.Primary.Attributes["name"]), nil
+				},
+			},
+		},
+	})
+}
+
+// testAccDeployDigitalOceanFunctionsAction deploys a function to the API host
+// of the namespace using the OpenWhisk API, authenticating with the
+// namespace's key.
+func testAccDeployDigitalOceanFunctionsAction(n string, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		attrs := rs.Primary.Attributes
+		url := fmt.Sprintf("%s/api/v1/namespaces/%s/actions/%s?overwrite=true",
+			strings.TrimSuffix(attrs["api_host"], "/"), rs.Primary.ID, name)
+		body := []byte(`{"exec": {"kind": "nodejs:default", "code": "function main(args) { return { body: 'Hello' } }"}}`)
+
+		req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.SetBasicAuth(attrs["uuid"], attrs["key"])
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			message, _ := io.ReadAll(resp.Body)
+			return fmt.Errorf("Error deploying function %s: %s: %s", name, resp.Status, message)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanFunctionsTriggerConfig_basic(cron string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "digitalocean_functions_trigger" "foobar" {
+  namespace_id = digitalocean_functions_namespace.foobar.id
+  name         = "every-five-minutes"
+  function     = "hello"
+  cron         = "%s"
+  is_enabled   = %t
+
+  body = jsonencode({
+    name = "trigger"
+  })
+}
+`, cron, enabled)
+}
@@ -0,0 +1,36 @@
+package genai
+
+import (
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanAnthropicApiKeys() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        AnthropicApiKeySchemaRead(),
+		ResultAttributeName: "anthropic_api_keys",
+		FlattenRecord:       flattenAnthropicApiKeyInfo,
+		GetRecords:          getDigitalOceanAnthropicApiKeys,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func DataSourceDigitalOceanAgentsByAnthropicApiKey() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanAgentsByAnthropicApiKeyRead,
+		Schema: map[string]*schema.Schema{
+			"uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The UUID of the Anthropic API key.",
+			},
+			"agents": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of agents associated with the Anthropic API key.",
+				Elem:        &schema.Resource{Schema: AgentSchemaRead()},
+			},
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package genai_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanAnthropicApiKeys_ListAll(t *testing.T) {
+	keyName := acceptance.RandomTestName() + "-anthropic-key"
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_genai_anthropic_api_key" "test" {
+  api_key = "sk-ant-testkey"
+  name    = "%s"
+}
+`, keyName)
+
+	dataSourceConfig := fmt.Sprintf(`
+data "digitalocean_genai_anthropic_api_keys" "all" {
+  filter {
+    key    = "name"
+    values = ["%s"]
+  }
+}
+`, keyName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_genai_anthropic_api_keys.all", "anthropic_api_keys.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_genai_anthropic_api_keys.all", "anthropic_api_keys.0.uuid",
+						"digitalocean_genai_anthropic_api_key.test", "uuid"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceDigitalOceanAgentsByAnthropicApiKey_ListAgents(t *testing.T) {
+	keyName := acceptance.RandomTestName() + "-anthropic-key"
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_genai_anthropic_api_key" "test" {
+  api_key = "sk-ant-testkey"
+  name    = "%s"
+}
+
+data "digitalocean_genai_agents_by_anthropic_api_key" "by_key" {
+  uuid = digitalocean_genai_anthropic_api_key.test.uuid
+}
+`, keyName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_genai_agents_by_anthropic_api_key.by_key", "agents.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -276,6 +276,77 @@ func FlattenOpenAIApiKeyInfo(openAIApiKey *godo.OpenAiApiKey) (map[string]interf
 	return result, nil
 }
 
+func getDigitalOceanAnthropicApiKeys(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var allAnthropicApiKeys []interface{}
+	for {
+		anthropicApiKeys, resp, err := client.GenAI.ListAnthropicAPIKeys(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving Anthropic API keys: %s", err)
+		}
+
+		for _, anthropicApiKey := range anthropicApiKeys {
+			if anthropicApiKey != nil {
+				allAnthropicApiKeys = append(allAnthropicApiKeys, anthropicApiKey)
+			}
+		}
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving Anthropic API keys: %s", err)
+		}
+		opts.Page = page + 1
+	}
+	return allAnthropicApiKeys, nil
+}
+
+func flattenAnthropicApiKeyInfo(rawDomain, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	anthropicApiKey, ok := rawDomain.(*godo.AnthropicApiKeyInfo)
+	if !ok || anthropicApiKey == nil {
+		// Return nil without error to safely skip nil or wrong type entries
+		return nil, nil
+	}
+	return FlattenAnthropicApiKeyInfo(anthropicApiKey)
+}
+
+func FlattenAnthropicApiKeyInfo(anthropicApiKey *godo.AnthropicApiKeyInfo) (map[string]interface{}, error) {
+	if anthropicApiKey == nil {
+		return nil, nil
+	}
+
+	result := map[string]interface{}{
+		"created_by": anthropicApiKey.CreatedBy,
+		"name":       anthropicApiKey.Name,
+		"uuid":       anthropicApiKey.Uuid,
+	}
+
+	if anthropicApiKey.DeletedAt != nil {
+		result["deleted_at"] = anthropicApiKey.DeletedAt.UTC().String()
+	} else {
+		result["deleted_at"] = ""
+	}
+	if anthropicApiKey.CreatedAt != nil {
+		result["created_at"] = anthropicApiKey.CreatedAt.UTC().String()
+	} else {
+		result["created_at"] = ""
+	}
+	if anthropicApiKey.UpdatedAt != nil {
+		result["updated_at"] = anthropicApiKey.UpdatedAt.UTC().String()
+	} else {
+		result["updated_at"] = ""
+	}
+
+	return result, nil
+}
+
 func extractDeploymentVisibility(old, new interface{}) (string, string) {
 	oldVisibility := ""
 	newVisibility := ""
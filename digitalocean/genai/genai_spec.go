@@ -804,6 +804,41 @@ func OpenAIApiKeySchemaRead() map[string]*schema.Schema {
 	}
 }
 
+func AnthropicApiKeySchemaRead() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"uuid": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Anthropic API Key Uuid",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp when the API Key was created",
+		},
+		"created_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Created By user ID for the API Key",
+		},
+		"deleted_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Deleted At timestamp for the API Key",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the API Key",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Updated At timestamp for the API Key",
+		},
+	}
+}
+
 func AgentSchemaRead() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"agent_id": {
@@ -0,0 +1,229 @@
+package genai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceDigitalOceanAgentAPIKey defines the DigitalOcean GenAI agent API key
+// resource used by consumers of an agent to authenticate. The secret is only
+// returned when the key is created or regenerated.
+func ResourceDigitalOceanAgentAPIKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanAgentAPIKeyCreate,
+		ReadContext:   resourceDigitalOceanAgentAPIKeyRead,
+		UpdateContext: resourceDigitalOceanAgentAPIKeyUpdate,
+		DeleteContext: resourceDigitalOceanAgentAPIKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDigitalOceanAgentAPIKeyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agent_uuid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The UUID of the agent the API key belongs to.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "A name for the API key.",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An arbitrary value that regenerates the secret of the API key whenever it changes.",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UUID of the API key.",
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret of the API key.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the API key was created.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Who created the API key.",
+			},
+		},
+
+		CustomizeDiff: customdiff.ComputedIf("secret_key", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+			return d.Id() != "" && d.HasChange("rotation_trigger")
+		}),
+	}
+}
+
+func resourceDigitalOceanAgentAPIKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	agentUUID := d.Get("agent_uuid").(string)
+	createRequest := &godo.AgentAPIKeyCreateRequest{
+		AgentUuid: agentUUID,
+		Name:      d.Get("name").(string),
+	}
+
+	apiKey, _, err := client.GenAI.CreateAgentAPIKey(ctx, agentUUID, createRequest)
+	if err != nil {
+		return diag.Errorf("error creating API key for agent (%s): %s", agentUUID, err)
+	}
+
+	d.SetId(apiKey.Uuid)
+	d.Set("secret_key", apiKey.SecretKey)
+
+	return resourceDigitalOceanAgentAPIKeyRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanAgentAPIKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	agentUUID := d.Get("agent_uuid").(string)
+	apiKey, resp, err := FindAgentAPIKey(ctx, client, agentUUID, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Agent (%s) not found", agentUUID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving API keys of agent (%s): %s", agentUUID, err)
+	}
+	if apiKey == nil {
+		log.Printf("[WARN] Agent API key (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("uuid", apiKey.Uuid)
+	d.Set("name", apiKey.Name)
+	d.Set("created_by", apiKey.CreatedBy)
+	if apiKey.CreatedAt != nil {
+		d.Set("created_at", apiKey.CreatedAt.UTC().String())
+	} else {
+		d.Set("created_at", "")
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanAgentAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	agentUUID := d.Get("agent_uuid").(string)
+
+	if d.HasChange("name") {
+		updateRequest := &godo.AgentAPIKeyUpdateRequest{
+			AgentUuid:  agentUUID,
+			APIKeyUuid: d.Id(),
+			Name:       d.Get("name").(string),
+		}
+
+		_, _, err := client.GenAI.UpdateAgentAPIKey(ctx, agentUUID, d.Id(), updateRequest)
+		if err != nil {
+			return diag.Errorf("error updating agent API key (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("rotation_trigger") {
+		apiKey, _, err := client.GenAI.RegenerateAgentAPIKey(ctx, agentUUID, d.Id())
+		if err != nil {
+			d.Partial(true)
+			return diag.Errorf("error regenerating agent API key (%s): %s", d.Id(), err)
+		}
+
+		d.Set("secret_key", apiKey.SecretKey)
+	}
+
+	return resourceDigitalOceanAgentAPIKeyRead(ctx, d, meta)
+}
+
+// agentAPIKeysRoot is the response of the endpoint listing the API keys of an
+// agent.
+type agentAPIKeysRoot struct {
+	APIKeys []*godo.ApiKeyInfo `json:"api_key_infos"`
+	Links   *godo.Links        `json:"links"`
+	Meta    *godo.Meta         `json:"meta"`
+}
+
+// FindAgentAPIKey looks up an API key, which has been deleted when nil is
+// returned, by paging through the API keys of its agent. The request is made
+// directly as godo's ListAgentAPIKeys ignores the list options and only
+// returns the first page.
+func FindAgentAPIKey(ctx context.Context, client *godo.Client, agentUUID string, apiKeyUUID string) (*godo.ApiKeyInfo, *godo.Response, error) {
+	page := 1
+	for {
+		path := fmt.Sprintf("/v2/gen-ai/agents/%s/api_keys?page=%d&per_page=200", agentUUID, page)
+		req, err := client.NewRequest(ctx, http.MethodGet, path, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		root := new(agentAPIKeysRoot)
+		resp, err := client.Do(ctx, req, root)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, key := range root.APIKeys {
+			if key != nil && key.Uuid == apiKeyUUID && key.DeletedAt == nil {
+				return key, resp, nil
+			}
+		}
+
+		if root.Links == nil || root.Links.IsLastPage() || len(root.APIKeys) == 0 {
+			return nil, resp, nil
+		}
+		page++
+	}
+}
+
+func resourceDigitalOceanAgentAPIKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	agentUUID := d.Get("agent_uuid").(string)
+
+	_, resp, err := client.GenAI.DeleteAgentAPIKey(ctx, agentUUID, d.Id())
+	if err != nil {
+		if resp != nil && resp.Response != nil && resp.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error deleting agent API key (%s): %s", d.Id(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanAgentAPIKeyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+		d.SetId(s[1])
+		d.Set("agent_uuid", s[0])
+	} else {
+		return nil, errors.New("must use the UUID of the agent and the UUID of the API key joined with a comma (e.g. `agent_uuid,api_key_uuid`)")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,142 @@
+package genai_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/genai"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanAgentAPIKey_Basic(t *testing.T) {
+	agentName := acceptance.RandomTestName()
+	keyName := acceptance.RandomTestName() + "-agent-key"
+	var secret string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanAgentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDigitalOceanAgentAPIKeyConfig(agentName, keyName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_genai_agent_api_key.test", "name", keyName),
+					resource.TestCheckResourceAttrPair(
+						"digitalocean_genai_agent_api_key.test", "agent_uuid", "digitalocean_genai_agent.test", "id"),
+					resource.TestCheckResourceAttrSet("digitalocean_genai_agent_api_key.test", "uuid"),
+					resource.TestCheckResourceAttrSet("digitalocean_genai_agent_api_key.test", "created_at"),
+					testAccCheckDigitalOceanAgentAPIKeySecret("digitalocean_genai_agent_api_key.test", &secret, false),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanAgentAPIKeyConfig(agentName, keyName+"-updated", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_genai_agent_api_key.test", "name", keyName+"-updated"),
+					resource.TestCheckResourceAttrPtr("digitalocean_genai_agent_api_key.test", "secret_key", &secret),
+				),
+			},
+			{
+				Config: testAccCheckDigitalOceanAgentAPIKeyConfig(agentName, keyName+"-updated", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanAgentAPIKeySecret("digitalocean_genai_agent_api_key.test", &secret, true),
+				),
+			},
+			{
+				ResourceName:      "digitalocean_genai_agent_api_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["digitalocean_genai_agent_api_key.test"]
+					return fmt.Sprintf("%s,%s", rs.Primary.Attributes["agent_uuid"], rs.Primary.ID), nil
+				},
+				// The secret is only returned when the key is created or
+				// regenerated.
+				ImportStateVerifyIgnore: []string{"secret_key", "rotation_trigger"},
+			},
+		},
+	})
+}
+
+// testAccCheckDigitalOceanAgentAPIKeySecret stores the secret of the key and,
+// when changed is set, checks that it differs from the previously stored one.
+func testAccCheckDigitalOceanAgentAPIKeySecret(resource string, secret *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+
+		current := rs.Primary.Attributes["secret_key"]
+		if current == "" {
+			return fmt.Errorf("No secret_key is set")
+		}
+		if changed && current == *secret {
+			return fmt.Errorf("Expected secret_key to be regenerated")
+		}
+
+		*secret = current
+		return nil
+	}
+}
+
+func testAccCheckDigitalOceanAgentAPIKeyConfig(agentName, keyName, rotationTrigger string) string {
+	return fmt.Sprintf(`%s
+
+resource "digitalocean_genai_agent_api_key" "test" {
+  agent_uuid       = digitalocean_genai_agent.test.id
+  name             = "%s"
+  rotation_trigger = "%s"
+}`, testAccCheckDigitalOceanAgentConfig_basic(agentName), keyName, rotationTrigger)
+}
+
+func TestFindAgentAPIKey(t *testing.T) {
+	var pages []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/gen-ai/agents/agent-uuid/api_keys", func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		switch page {
+		case "1":
+			fmt.Fprintf(w, `{"api_key_infos": [{"uuid": "key-1"}, {"uuid": "key-2", "deleted_at": "2025-01-01T00:00:00Z"}],
+  "links": {"pages": {"next": "http://%s/v2/gen-ai/agents/agent-uuid/api_keys?page=2"}}}`, r.Host)
+		case "2":
+			w.Write([]byte(`{"api_key_infos": [{"uuid": "key-3", "name": "found"}], "links": {}}`))
+		default:
+			t.Errorf("unexpected page %q", page)
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := godo.New(http.DefaultClient, godo.SetBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	apiKey, _, err := genai.FindAgentAPIKey(context.Background(), client, "agent-uuid", "key-3")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if apiKey == nil || apiKey.Name != "found" {
+		t.Errorf("API key on the second page was not found: %+v", apiKey)
+	}
+
+	pages = nil
+	apiKey, _, err = genai.FindAgentAPIKey(context.Background(), client, "agent-uuid", "key-2")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if apiKey != nil {
+		t.Errorf("deleted API key was found: %+v", apiKey)
+	}
+	if len(pages) != 2 {
+		t.Errorf("retrieved pages %v, expected both pages", pages)
+	}
+}
@@ -0,0 +1,160 @@
+package genai
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceDigitalOceanAnthropicApiKey defines the DigitalOcean GenAI Anthropic
+// API key resource. The key itself is never returned by the API, so it is only
+// sent on create and when it changes.
+func ResourceDigitalOceanAnthropicApiKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanAnthropicApiKeyCreate,
+		ReadContext:   resourceDigitalOceanAnthropicApiKeyRead,
+		UpdateContext: resourceDigitalOceanAnthropicApiKeyUpdate,
+		DeleteContext: resourceDigitalOceanAnthropicApiKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The Anthropic API key.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "A name for the API key.",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UUID of the API key.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the API key was created.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Who created the API key.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the API key was last updated.",
+			},
+			"deleted_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the API key was deleted.",
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanAnthropicApiKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	anthropicRequest := &godo.AnthropicAPIKeyCreateRequest{
+		ApiKey: d.Get("api_key").(string),
+		Name:   d.Get("name").(string),
+	}
+
+	apiKey, _, err := client.GenAI.CreateAnthropicAPIKey(ctx, anthropicRequest)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(apiKey.Uuid)
+	return resourceDigitalOceanAnthropicApiKeyRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanAnthropicApiKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	apiKey, resp, err := client.GenAI.GetAnthropicAPIKey(ctx, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Anthropic API key (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if apiKey == nil {
+		d.SetId("")
+		return nil
+	}
+
+	flattened, err := FlattenAnthropicApiKeyInfo(apiKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := util.SetResourceDataFromMap(d, flattened); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(apiKey.Uuid)
+
+	return nil
+}
+
+func resourceDigitalOceanAnthropicApiKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	if !d.HasChanges("name", "api_key") {
+		return nil
+	}
+
+	anthropicRequest := &godo.AnthropicAPIKeyUpdateRequest{
+		Name:       d.Get("name").(string),
+		ApiKeyUuid: d.Id(),
+	}
+	// Only send the key when it changes so that renaming an imported key does
+	// not require the key to be known.
+	if d.HasChange("api_key") {
+		anthropicRequest.ApiKey = d.Get("api_key").(string)
+	}
+
+	_, _, err := client.GenAI.UpdateAnthropicAPIKey(ctx, d.Id(), anthropicRequest)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDigitalOceanAnthropicApiKeyRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanAnthropicApiKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	id := d.Id()
+
+	_, resp, err := client.GenAI.DeleteAnthropicAPIKey(ctx, id)
+	if err != nil {
+		if resp != nil && resp.Response != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error deleting Anthropic API Key (%s): %s", id, err))
+	}
+
+	d.SetId("")
+	return nil
+}
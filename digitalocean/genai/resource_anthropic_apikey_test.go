@@ -0,0 +1,99 @@
+package genai_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanAnthropicApiKey_Basic(t *testing.T) {
+	keyName := acceptance.RandomTestName() + "-anthropic-key"
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_genai_anthropic_api_key" "test" {
+  api_key = "sk-ant-testkey"
+  name    = "%s"
+}
+`, keyName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_genai_anthropic_api_key.test", "name", keyName),
+					resource.TestCheckResourceAttr("digitalocean_genai_anthropic_api_key.test", "api_key", "sk-ant-testkey"),
+					resource.TestCheckResourceAttrSet("digitalocean_genai_anthropic_api_key.test", "uuid"),
+					resource.TestCheckResourceAttrSet("digitalocean_genai_anthropic_api_key.test", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanAnthropicApiKey_Update(t *testing.T) {
+	keyName := acceptance.RandomTestName() + "-anthropic-key"
+	updatedKeyName := keyName + "-updated"
+
+	resourceConfig := `
+resource "digitalocean_genai_anthropic_api_key" "test" {
+  api_key = "%s"
+  name    = "%s"
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(resourceConfig, "sk-ant-testkey", keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_genai_anthropic_api_key.test", "name", keyName),
+				),
+			},
+			{
+				Config: fmt.Sprintf(resourceConfig, "sk-ant-testkey", updatedKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_genai_anthropic_api_key.test", "name", updatedKeyName),
+				),
+			},
+			{
+				Config: fmt.Sprintf(resourceConfig, "sk-ant-rotatedkey", updatedKeyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_genai_anthropic_api_key.test", "api_key", "sk-ant-rotatedkey"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanAnthropicApiKey_Import(t *testing.T) {
+	keyName := acceptance.RandomTestName() + "-anthropic-key"
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_genai_anthropic_api_key" "test" {
+  api_key = "sk-ant-testkey"
+  name    = "%s"
+}
+`, keyName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				ResourceName:      "digitalocean_genai_anthropic_api_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The key is never returned by the API.
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+		},
+	})
+}
@@ -218,7 +218,7 @@ func dataSourceDigitalOceanKubernetesClusterRead(ctx context.Context, d *schema.
 		if c.Name == d.Get("name").(string) {
 			d.SetId(c.ID)
 
-			return digitaloceanKubernetesClusterRead(client, c, d)
+			return digitaloceanKubernetesClusterRead(client, meta.(*config.CombinedConfig).PriceCatalog(), c, d)
 		}
 	}
 
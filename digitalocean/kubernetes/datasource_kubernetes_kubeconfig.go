@@ -0,0 +1,246 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	kubeconfigAuthMethodToken = "token"
+	kubeconfigAuthMethodExec  = "exec"
+
+	kubeconfigExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+)
+
+// kubernetesExecConfig is a kubeconfig whose users authenticate using an exec
+// plugin rather than static credentials.
+type kubernetesExecConfig struct {
+	APIVersion     string                     `yaml:"apiVersion"`
+	Kind           string                     `yaml:"kind"`
+	Clusters       []kubernetesConfigCluster  `yaml:"clusters"`
+	Contexts       []kubernetesConfigContext  `yaml:"contexts"`
+	CurrentContext string                     `yaml:"current-context"`
+	Users          []kubernetesExecConfigUser `yaml:"users"`
+}
+
+type kubernetesExecConfigUser struct {
+	Name string                       `yaml:"name"`
+	User kubernetesExecConfigUserData `yaml:"user"`
+}
+
+type kubernetesExecConfigUserData struct {
+	Exec kubernetesConfigExec `yaml:"exec"`
+}
+
+type kubernetesConfigExec struct {
+	APIVersion string   `yaml:"apiVersion"`
+	Command    string   `yaml:"command"`
+	Args       []string `yaml:"args,omitempty"`
+}
+
+func DataSourceDigitalOceanKubernetesKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanKubernetesKubeconfigRead,
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"context_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"auth_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  kubeconfigAuthMethodToken,
+				ValidateFunc: validation.StringInSlice([]string{
+					kubeconfigAuthMethodToken,
+					kubeconfigAuthMethodExec,
+				}, false),
+			},
+			"expiry_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"exec_command": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "doctl",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"doctl_context": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"current_context": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"contexts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"raw_config": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanKubernetesKubeconfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	authMethod := d.Get("auth_method").(string)
+	merged := &kubernetesConfig{
+		APIVersion: "v1",
+		Kind:       "Config",
+	}
+	contexts := make([]string, 0)
+	seen := make(map[string]bool)
+	seenClusters := make(map[string]bool)
+	seenUsers := make(map[string]bool)
+	var mergedExecUsers []kubernetesExecConfigUser
+
+	for _, raw := range d.Get("cluster").([]interface{}) {
+		cluster := raw.(map[string]interface{})
+		clusterID := cluster["cluster_id"].(string)
+
+		var kubeconfigYAML []byte
+		if v, ok := d.GetOk("expiry_seconds"); ok && authMethod == kubeconfigAuthMethodToken {
+			kubeconfig, _, err := client.Kubernetes.GetKubeConfigWithExpiry(context.Background(), clusterID, int64(v.(int)))
+			if err != nil {
+				return diag.Errorf("Error retrieving kubeconfig for Kubernetes cluster %s: %s", clusterID, err)
+			}
+			kubeconfigYAML = kubeconfig.KubeconfigYAML
+		} else {
+			kubeconfig, _, err := client.Kubernetes.GetKubeConfig(context.Background(), clusterID)
+			if err != nil {
+				return diag.Errorf("Error retrieving kubeconfig for Kubernetes cluster %s: %s", clusterID, err)
+			}
+			kubeconfigYAML = kubeconfig.KubeconfigYAML
+		}
+
+		var kubeconfig kubernetesConfig
+		if err := yaml.Unmarshal(kubeconfigYAML, &kubeconfig); err != nil {
+			return diag.Errorf("Error parsing kubeconfig for Kubernetes cluster %s: %s", clusterID, err)
+		}
+
+		execUsers := execKubeconfigUsers(kubeconfig.Users, clusterID, d.Get("exec_command").(string), d.Get("doctl_context").(string))
+
+		if name, ok := cluster["context_name"].(string); ok && name != "" {
+			for i := range kubeconfig.Contexts {
+				kubeconfig.Contexts[i].Name = name
+			}
+		}
+
+		for _, c := range kubeconfig.Contexts {
+			if seen[c.Name] {
+				return diag.Errorf("Duplicate kubeconfig context name %q, set a unique context_name for cluster %s", c.Name, clusterID)
+			}
+			seen[c.Name] = true
+			contexts = append(contexts, c.Name)
+		}
+
+		merged.Contexts = append(merged.Contexts, kubeconfig.Contexts...)
+
+		// The same cluster may be listed more than once under different
+		// context names, in which case its cluster and user entries are shared.
+		for _, c := range kubeconfig.Clusters {
+			if !seenClusters[c.Name] {
+				seenClusters[c.Name] = true
+				merged.Clusters = append(merged.Clusters, c)
+			}
+		}
+		for i, u := range kubeconfig.Users {
+			if !seenUsers[u.Name] {
+				seenUsers[u.Name] = true
+				merged.Users = append(merged.Users, u)
+				mergedExecUsers = append(mergedExecUsers, execUsers[i])
+			}
+		}
+	}
+
+	merged.CurrentContext = d.Get("current_context").(string)
+	if merged.CurrentContext == "" && len(contexts) > 0 {
+		merged.CurrentContext = contexts[0]
+	}
+	if !seen[merged.CurrentContext] {
+		return diag.Errorf("current_context %q does not match any of the kubeconfig contexts: %v", merged.CurrentContext, contexts)
+	}
+
+	var rawConfig []byte
+	var err error
+	if authMethod == kubeconfigAuthMethodExec {
+		rawConfig, err = yaml.Marshal(&kubernetesExecConfig{
+			APIVersion:     merged.APIVersion,
+			Kind:           merged.Kind,
+			Clusters:       merged.Clusters,
+			Contexts:       merged.Contexts,
+			CurrentContext: merged.CurrentContext,
+			Users:          mergedExecUsers,
+		})
+	} else {
+		rawConfig, err = yaml.Marshal(merged)
+	}
+	if err != nil {
+		return diag.Errorf("Error rendering kubeconfig: %s", err)
+	}
+
+	d.SetId(id.UniqueId())
+	d.Set("current_context", merged.CurrentContext)
+	d.Set("contexts", contexts)
+	d.Set("raw_config", string(rawConfig))
+
+	return nil
+}
+
+// execKubeconfigUsers returns the given users with their static credentials
+// replaced by an exec plugin configuration that fetches short-lived
+// credentials on demand.
+func execKubeconfigUsers(users []kubernetesConfigUser, clusterID, command, doctlContext string) []kubernetesExecConfigUser {
+	args := []string{"kubernetes", "cluster", "kubeconfig", "exec-credential", "--version=v1beta1"}
+	if doctlContext != "" {
+		args = append(args, fmt.Sprintf("--context=%s", doctlContext))
+	}
+	args = append(args, clusterID)
+
+	execUsers := make([]kubernetesExecConfigUser, 0, len(users))
+	for _, u := range users {
+		execUsers = append(execUsers, kubernetesExecConfigUser{
+			Name: u.Name,
+			User: kubernetesExecConfigUserData{
+				Exec: kubernetesConfigExec{
+					APIVersion: kubeconfigExecAPIVersion,
+					Command:    command,
+					Args:       args,
+				},
+			},
+		})
+	}
+
+	return execUsers
+}
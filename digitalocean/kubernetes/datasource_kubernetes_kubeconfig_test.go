@@ -0,0 +1,92 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanKubernetesKubeconfig_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	resourceConfig := fmt.Sprintf(`%s
+resource "digitalocean_kubernetes_cluster" "foo" {
+  name    = "%s"
+  region  = "lon1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersionLatest, rName)
+
+	tokenConfig := `
+data "digitalocean_kubernetes_kubeconfig" "foobar" {
+  expiry_seconds = 3600
+
+  cluster {
+    cluster_id   = digitalocean_kubernetes_cluster.foo.id
+    context_name = "primary"
+  }
+}
+`
+
+	execConfig := `
+data "digitalocean_kubernetes_kubeconfig" "foobar" {
+  auth_method   = "exec"
+  doctl_context = "ci"
+
+  cluster {
+    cluster_id   = digitalocean_kubernetes_cluster.foo.id
+    context_name = "primary"
+  }
+
+  cluster {
+    cluster_id   = digitalocean_kubernetes_cluster.foo.id
+    context_name = "secondary"
+  }
+
+  current_context = "secondary"
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foo", &k8s),
+				),
+			},
+			{
+				Config: resourceConfig + tokenConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_kubeconfig.foobar", "current_context", "primary"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_kubeconfig.foobar", "contexts.#", "1"),
+					resource.TestMatchResourceAttr("data.digitalocean_kubernetes_kubeconfig.foobar", "raw_config", regexp.MustCompile(`token: `)),
+				),
+			},
+			{
+				Config: resourceConfig + execConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_kubeconfig.foobar", "current_context", "secondary"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_kubeconfig.foobar", "contexts.#", "2"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_kubeconfig.foobar", "contexts.0", "primary"),
+					resource.TestMatchResourceAttr("data.digitalocean_kubernetes_kubeconfig.foobar", "raw_config", regexp.MustCompile(`command: doctl`)),
+					resource.TestMatchResourceAttr("data.digitalocean_kubernetes_kubeconfig.foobar", "raw_config", regexp.MustCompile(`--context=ci`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,177 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanKubernetesNodePoolTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDigitalOceanKubernetesNodePoolTemplateRead,
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"node_pool_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"taints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"capacity":    nodeResourcesSchema(),
+			"allocatable": nodeResourcesSchema(),
+		},
+	}
+}
+
+func nodeResourcesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"cpu": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"memory": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"memory_bytes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"pods": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDigitalOceanKubernetesNodePoolTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+	poolName := d.Get("node_pool_name").(string)
+
+	template, _, err := client.Kubernetes.GetNodePoolTemplate(context.Background(), clusterID, poolName)
+	if err != nil {
+		return diag.Errorf("Error retrieving Kubernetes node pool template: %s", err)
+	}
+
+	if template.Template == nil {
+		return diag.Errorf("No template found for Kubernetes node pool %s", poolName)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", clusterID, poolName))
+	d.Set("name", template.Template.Name)
+	d.Set("size", template.Template.Slug)
+	d.Set("labels", flattenLabels(template.Template.Labels))
+	d.Set("taints", template.Template.Taints)
+
+	if err := d.Set("capacity", flattenNodePoolResources(template.Template.Capacity)); err != nil {
+		return diag.Errorf("Error setting capacity: %s", err)
+	}
+
+	if err := d.Set("allocatable", flattenNodePoolResources(template.Template.Allocatable)); err != nil {
+		return diag.Errorf("Error setting allocatable: %s", err)
+	}
+
+	return nil
+}
+
+func flattenNodePoolResources(resources *godo.KubernetesNodePoolResources) []interface{} {
+	if resources == nil {
+		return []interface{}{}
+	}
+
+	memoryBytes, err := ParseQuantityBytes(resources.Memory)
+	if err != nil {
+		log.Printf("[WARN] Unable to parse node memory %q: %s", resources.Memory, err)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cpu":          resources.CPU,
+			"memory":       resources.Memory,
+			"memory_bytes": memoryBytes,
+			"pods":         resources.Pods,
+		},
+	}
+}
+
+var quantitySuffixes = []struct {
+	suffix     string
+	multiplier float64
+}{
+	// binary suffixes are checked first so that e.g. "Mi" is not taken for "M"
+	{"Ki", 1 << 10},
+	{"Mi", 1 << 20},
+	{"Gi", 1 << 30},
+	{"Ti", 1 << 40},
+	{"Pi", 1 << 50},
+	{"k", 1e3},
+	{"M", 1e6},
+	{"G", 1e9},
+	{"T", 1e12},
+	{"P", 1e15},
+}
+
+// ParseQuantityBytes converts a Kubernetes resource quantity such as "3892Mi"
+// or "4G" into a number of bytes.
+func ParseQuantityBytes(quantity string) (int64, error) {
+	if quantity == "" {
+		return 0, nil
+	}
+
+	number, multiplier := quantity, 1.0
+	for _, s := range quantitySuffixes {
+		if strings.HasSuffix(quantity, s.suffix) {
+			number = strings.TrimSuffix(quantity, s.suffix)
+			multiplier = s.multiplier
+			break
+		}
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid quantity %q", quantity)
+	}
+
+	return int64(value * multiplier), nil
+}
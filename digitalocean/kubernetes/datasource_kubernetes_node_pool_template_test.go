@@ -0,0 +1,109 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/kubernetes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanKubernetesNodePoolTemplate_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	resourceConfig := fmt.Sprintf(`%s
+resource "digitalocean_kubernetes_cluster" "foo" {
+  name    = "%s"
+  region  = "lon1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+    labels = {
+      priority = "high"
+    }
+  }
+}
+`, testClusterVersionLatest, rName)
+
+	dataSourceConfig := `
+data "digitalocean_kubernetes_node_pool_template" "foobar" {
+  cluster_id     = digitalocean_kubernetes_cluster.foo.id
+  node_pool_name = "default"
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foo", &k8s),
+				),
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_node_pool_template.foobar", "size", "s-1vcpu-2gb"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_node_pool_template.foobar", "labels.priority", "high"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_node_pool_template.foobar", "capacity.#", "1"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_node_pool_template.foobar", "capacity.0.cpu"),
+					resource.TestCheckResourceAttr("data.digitalocean_kubernetes_node_pool_template.foobar", "allocatable.#", "1"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_node_pool_template.foobar", "allocatable.0.memory"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_node_pool_template.foobar", "allocatable.0.memory_bytes"),
+					resource.TestCheckResourceAttrSet("data.digitalocean_kubernetes_node_pool_template.foobar", "allocatable.0.pods"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseQuantityBytes(t *testing.T) {
+	tests := []struct {
+		quantity string
+		want     int64
+		wantErr  bool
+	}{
+		{quantity: "", want: 0},
+		{quantity: "1024", want: 1024},
+		{quantity: "1Ki", want: 1024},
+		{quantity: "3892Mi", want: 3892 * 1024 * 1024},
+		{quantity: "4Gi", want: 4 * 1024 * 1024 * 1024},
+		{quantity: "1.5Gi", want: 1536 * 1024 * 1024},
+		{quantity: "1Ti", want: 1 << 40},
+		{quantity: "1Pi", want: 1 << 50},
+		{quantity: "1k", want: 1000},
+		{quantity: "500M", want: 500000000},
+		{quantity: "4G", want: 4000000000},
+		{quantity: "2T", want: 2000000000000},
+		{quantity: "1P", want: 1000000000000000},
+		{quantity: "Mi", wantErr: true},
+		{quantity: "four", wantErr: true},
+		{quantity: "4Xi", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := kubernetes.ParseQuantityBytes(tt.quantity)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseQuantityBytes(%q) returned %d, expected an error", tt.quantity, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseQuantityBytes(%q) returned error: %s", tt.quantity, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseQuantityBytes(%q) returned %d, expected %d", tt.quantity, got, tt.want)
+		}
+	}
+}
@@ -1,9 +1,12 @@
 package kubernetes
 
 import (
+	"context"
+	"log"
 	"strings"
 
 	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/pricing"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -96,6 +99,18 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 			Optional: true,
 			Elem:     nodePoolTaintSchema(),
 		},
+
+		"price_monthly": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The monthly price of the node pool's nodes in USD",
+		},
+
+		"price_hourly": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The hourly price of the node pool's nodes in USD",
+		},
 	}
 
 	if isResource {
@@ -107,6 +122,28 @@ func nodePoolSchema(isResource bool) map[string]*schema.Schema {
 			ForceNew:     true,
 		}
 
+		// a standalone node pool may replace itself in place when its size
+		// changes, so whether a size change forces a new resource is decided
+		// in the resource's CustomizeDiff based on the replacement_strategy
+		s["size"].ForceNew = false
+
+		s["replacement_strategy"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  NodePoolReplacementStrategyRecreate,
+			ValidateFunc: validation.StringInSlice([]string{
+				NodePoolReplacementStrategyRecreate,
+				NodePoolReplacementStrategyBlueGreen,
+			}, false),
+		}
+
+		s["drain_period_seconds"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      300,
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+
 		// remove the id when this is used in a specific resource
 		// not as a child
 		delete(s, "id")
@@ -316,7 +353,7 @@ func flattenControlPlaneFirewallOpts(opts *godo.KubernetesControlPlaneFirewall)
 	return result
 }
 
-func flattenNodePool(d *schema.ResourceData, keyPrefix string, pool *godo.KubernetesNodePool, parentTags ...string) []interface{} {
+func flattenNodePool(d *schema.ResourceData, keyPrefix string, pool *godo.KubernetesNodePool, price pricing.Price, parentTags ...string) []interface{} {
 	rawPool := map[string]interface{}{
 		"id":                pool.ID,
 		"name":              pool.Name,
@@ -326,6 +363,8 @@ func flattenNodePool(d *schema.ResourceData, keyPrefix string, pool *godo.Kubern
 		"min_nodes":         pool.MinNodes,
 		"max_nodes":         pool.MaxNodes,
 		"taint":             pool.Taints,
+		"price_monthly":     price.Monthly,
+		"price_hourly":      price.Hourly,
 	}
 
 	if pool.Tags != nil {
@@ -508,3 +547,16 @@ func flattenCAConfigOpts(opts *godo.KubernetesClusterAutoscalerConfiguration) []
 
 	return result
 }
+
+// nodePoolPrice returns the price of a node pool's nodes. Pricing is
+// informational, so sizes missing from the catalog are logged rather than
+// failing the read.
+func nodePoolPrice(ctx context.Context, catalog *pricing.Catalog, size string, count int) pricing.Price {
+	price, err := catalog.DropletSize(ctx, size)
+	if err != nil {
+		log.Printf("[WARN] Unable to price Kubernetes node pool: %s", err)
+		return pricing.Price{}
+	}
+
+	return price.Times(count).Rounded()
+}
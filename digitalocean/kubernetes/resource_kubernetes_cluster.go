@@ -5,12 +5,15 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/pricing"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
@@ -70,10 +73,21 @@ func ResourceDigitalOceanKubernetesCluster() *schema.Resource {
 			},
 
 			"registry_integration": {
-				Type:     schema.TypeBool,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ForceNew:      false,
+				ConflictsWith: []string{"registries"},
+			},
+
+			"registries": {
+				Type:     schema.TypeSet,
 				Optional: true,
-				Default:  false,
-				ForceNew: false,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				ConflictsWith: []string{"registry_integration"},
 			},
 
 			"version": {
@@ -426,6 +440,13 @@ func resourceDigitalOceanKubernetesClusterCreate(ctx context.Context, d *schema.
 		}
 	}
 
+	if registries := expandRegistryNames(d.Get("registries").(*schema.Set)); len(registries) > 0 {
+		err = addKubernetesRegistries(client, cluster.ID, registries)
+		if err != nil {
+			return diag.Errorf("Error attaching container registries: %s", err)
+		}
+	}
+
 	return resourceDigitalOceanKubernetesClusterRead(ctx, d, meta)
 }
 
@@ -442,11 +463,12 @@ func resourceDigitalOceanKubernetesClusterRead(ctx context.Context, d *schema.Re
 		return diag.Errorf("Error retrieving Kubernetes cluster: %s", err)
 	}
 
-	return digitaloceanKubernetesClusterRead(client, cluster, d)
+	return digitaloceanKubernetesClusterRead(client, meta.(*config.CombinedConfig).PriceCatalog(), cluster, d)
 }
 
 func digitaloceanKubernetesClusterRead(
 	client *godo.Client,
+	catalog *pricing.Catalog,
 	cluster *godo.KubernetesCluster,
 	d *schema.ResourceData,
 ) diag.Diagnostics {
@@ -467,6 +489,13 @@ func digitaloceanKubernetesClusterRead(
 	d.Set("auto_upgrade", cluster.AutoUpgrade)
 	d.Set("urn", cluster.URN())
 
+	// The API only reports whether registries are integrated with the
+	// cluster, not which ones, so registries detached outside of Terraform are
+	// only detected once none are left.
+	if !cluster.RegistryEnabled {
+		d.Set("registries", []string{})
+	}
+
 	if err := d.Set(controlPlaneFirewallField, flattenControlPlaneFirewallOpts(cluster.ControlPlaneFirewall)); err != nil {
 		return diag.Errorf("[DEBUG] Error setting %s - error: %#v", controlPlaneFirewallField, err)
 	}
@@ -494,7 +523,8 @@ func digitaloceanKubernetesClusterRead(
 				}
 
 				keyPrefix := fmt.Sprintf("node_pool.%d.", i)
-				if err := d.Set("node_pool", flattenNodePool(d, keyPrefix, p, cluster.Tags...)); err != nil {
+				price := nodePoolPrice(context.Background(), catalog, p.Size, p.Count)
+				if err := d.Set("node_pool", flattenNodePool(d, keyPrefix, p, price, cluster.Tags...)); err != nil {
 					log.Printf("[DEBUG] Error setting node pool attributes: %s %#v", err, cluster.NodePools)
 				}
 
@@ -613,6 +643,25 @@ func resourceDigitalOceanKubernetesClusterUpdate(ctx context.Context, d *schema.
 		}
 	}
 
+	if d.HasChange("registries") {
+		old, new := d.GetChange("registries")
+		remove, add := util.GetSetChanges(old.(*schema.Set), new.(*schema.Set))
+
+		if remove.Len() > 0 {
+			err := removeKubernetesRegistries(client, d.Id(), expandRegistryNames(remove))
+			if err != nil {
+				return diag.Errorf("Error detaching container registries: %s", err)
+			}
+		}
+
+		if add.Len() > 0 {
+			err := addKubernetesRegistries(client, d.Id(), expandRegistryNames(add))
+			if err != nil {
+				return diag.Errorf("Error attaching container registries: %s", err)
+			}
+		}
+	}
+
 	return resourceDigitalOceanKubernetesClusterRead(ctx, d, meta)
 }
 
@@ -725,6 +774,94 @@ func disableRegistryIntegration(client *godo.Client, clusterUUID string) error {
 	return err
 }
 
+// kubernetesRegistriesRequest integrates specific container registries with
+// clusters. godo's Kubernetes.AddRegistry and Kubernetes.RemoveRegistry only
+// support the account's default registry, so additional registries of the
+// multi-registry Registries service are named in a request of their own.
+type kubernetesRegistriesRequest struct {
+	ClusterUUIDs []string `json:"cluster_uuids"`
+	Registries   []string `json:"registries"`
+}
+
+const kubernetesRegistriesPath = "v2/kubernetes/registries"
+
+func addKubernetesRegistries(client *godo.Client, clusterUUID string, registries []string) error {
+	named, err := splitDefaultRegistry(client, registries)
+	if err != nil {
+		return err
+	}
+
+	if len(named) < len(registries) {
+		if err := enableRegistryIntegration(client, clusterUUID); err != nil {
+			return err
+		}
+	}
+
+	return doKubernetesRegistriesRequest(client, http.MethodPost, clusterUUID, named)
+}
+
+func removeKubernetesRegistries(client *godo.Client, clusterUUID string, registries []string) error {
+	named, err := splitDefaultRegistry(client, registries)
+	if err != nil {
+		return err
+	}
+
+	if len(named) < len(registries) {
+		if err := disableRegistryIntegration(client, clusterUUID); err != nil {
+			return err
+		}
+	}
+
+	return doKubernetesRegistriesRequest(client, http.MethodDelete, clusterUUID, named)
+}
+
+// splitDefaultRegistry removes the account's default registry from a list of
+// registry names, so that it is attached using the godo calls. The remaining
+// registries are returned.
+func splitDefaultRegistry(client *godo.Client, registries []string) ([]string, error) {
+	defaultRegistry, resp, err := client.Registry.Get(context.Background())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return registries, nil
+		}
+
+		return nil, fmt.Errorf("Error retrieving container registry: %s", err)
+	}
+
+	named := make([]string, 0, len(registries))
+	for _, name := range registries {
+		if name != defaultRegistry.Name {
+			named = append(named, name)
+		}
+	}
+	return named, nil
+}
+
+func doKubernetesRegistriesRequest(client *godo.Client, method string, clusterUUID string, registries []string) error {
+	if len(registries) == 0 {
+		return nil
+	}
+
+	req, err := client.NewRequest(context.Background(), method, kubernetesRegistriesPath, &kubernetesRegistriesRequest{
+		ClusterUUIDs: []string{clusterUUID},
+		Registries:   registries,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(context.Background(), req, nil)
+	return err
+}
+
+func expandRegistryNames(set *schema.Set) []string {
+	names := make([]string, 0, set.Len())
+	for _, name := range set.List() {
+		names = append(names, name.(string))
+	}
+	return names
+}
+
 func waitForKubernetesClusterCreate(client *godo.Client, d *schema.ResourceData) (*godo.KubernetesCluster, error) {
 	var (
 		tickerInterval = 10 * time.Second
@@ -287,6 +287,76 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 	})
 }
 
+func TestAccDigitalOceanKubernetesCluster_CreateWithRegistries(t *testing.T) {
+	var (
+		rName = acceptance.RandomTestName()
+		k8s   godo.KubernetesCluster
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`%s
+
+resource "digitalocean_container_registry" "foobar" {
+  name                   = "%s"
+  region                 = "nyc3"
+  subscription_tier_slug = "starter"
+}
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name       = "%s"
+  region     = "nyc3"
+  version    = data.digitalocean_kubernetes_versions.test.latest_version
+  registries = [digitalocean_container_registry.foobar.name]
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersionLatest, rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "registries.#", "1"),
+					resource.TestCheckTypeSetElemAttr("digitalocean_kubernetes_cluster.foobar", "registries.*", rName),
+				),
+			},
+			// Detach the registry
+			{
+				Config: fmt.Sprintf(`%s
+
+resource "digitalocean_container_registry" "foobar" {
+  name                   = "%s"
+  region                 = "nyc3"
+  subscription_tier_slug = "starter"
+}
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "nyc3"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersionLatest, rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "registries.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanKubernetesCluster_UpdateCluster(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
@@ -2,14 +2,19 @@ package kubernetes
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/tag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -17,6 +22,26 @@ import (
 // we automatically add this tag to the default pool
 const DigitaloceanKubernetesDefaultNodePoolTag = "terraform:default-node-pool"
 
+const (
+	// NodePoolReplacementStrategyRecreate deletes a node pool and creates a new
+	// one when its size changes.
+	NodePoolReplacementStrategyRecreate = "recreate"
+	// NodePoolReplacementStrategyBlueGreen creates a sibling node pool with the
+	// new size and only deletes the old pool once the new one is running.
+	NodePoolReplacementStrategyBlueGreen = "blue_green"
+
+	// nodePoolReplacedTaintKey is applied to a node pool being replaced to
+	// cordon its nodes while the workloads move to the new pool.
+	nodePoolReplacedTaintKey = "terraform/replaced"
+
+	// nodePoolNameMaxLength is the maximum length of a Kubernetes label
+	// value, which node pool names are used as.
+	nodePoolNameMaxLength = 63
+	// nodePoolReplacementSuffixLength is the length of the random suffix of
+	// the temporary name of a replacement node pool.
+	nodePoolReplacementSuffixLength = 8
+)
+
 func ResourceDigitalOceanKubernetesNodePool() *schema.Resource {
 
 	return &schema.Resource{
@@ -33,8 +58,19 @@ func ResourceDigitalOceanKubernetesNodePool() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
+
+		CustomizeDiff: customdiff.All(
+			// Unless a blue/green replacement was requested, changing the size
+			// of a node pool requires deleting and recreating it.
+			customdiff.ForceNewIf("size", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.Id() != "" && d.HasChange("size") &&
+					d.Get("replacement_strategy").(string) != NodePoolReplacementStrategyBlueGreen
+			}),
+			customizeDiffKubernetesNodePoolPrice,
+		),
 	}
 }
 
@@ -89,6 +125,10 @@ func resourceDigitalOceanKubernetesNodePoolRead(ctx context.Context, d *schema.R
 	d.Set("nodes", flattenNodes(pool.Nodes))
 	d.Set("taint", flattenNodePoolTaints(pool.Taints))
 
+	price := nodePoolPrice(ctx, meta.(*config.CombinedConfig).PriceCatalog(), pool.Size, pool.Count)
+	d.Set("price_monthly", price.Monthly)
+	d.Set("price_hourly", price.Hourly)
+
 	// Assign a node_count only if it's been set explicitly, since it's
 	// optional and we don't want to update with a 0 if it's not set.
 	if _, ok := d.GetOk("node_count"); ok {
@@ -98,9 +138,43 @@ func resourceDigitalOceanKubernetesNodePoolRead(ctx context.Context, d *schema.R
 	return nil
 }
 
+// customizeDiffKubernetesNodePoolPrice shows the price of the node pool in the
+// plan when its size or node count changes. The price of autoscaled node pools
+// without a node_count is only known once the pool has been created.
+func customizeDiffKubernetesNodePoolPrice(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("size", "node_count") {
+		return nil
+	}
+
+	count := d.Get("node_count").(int)
+	if !d.NewValueKnown("size") || !d.NewValueKnown("node_count") || count == 0 {
+		d.SetNewComputed("price_monthly")
+		d.SetNewComputed("price_hourly")
+		return nil
+	}
+
+	price := nodePoolPrice(ctx, meta.(*config.CombinedConfig).PriceCatalog(), d.Get("size").(string), count)
+	if err := d.SetNew("price_monthly", price.Monthly); err != nil {
+		return err
+	}
+
+	return d.SetNew("price_hourly", price.Hourly)
+}
+
 func resourceDigitalOceanKubernetesNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
+	if d.HasChange("size") {
+		return resourceDigitalOceanKubernetesNodePoolBlueGreenReplace(ctx, d, meta)
+	}
+
+	// replacement_strategy and drain_period_seconds only configure how the
+	// node pool is replaced, so changing them alone, for instance when they
+	// are first set to their defaults, does not need an update of the pool.
+	if !d.HasChanges("name", "tags", "node_count", "labels", "auto_scale", "min_nodes", "max_nodes", "taint") {
+		return resourceDigitalOceanKubernetesNodePoolRead(ctx, d, meta)
+	}
+
 	rawPool := map[string]interface{}{
 		"name": d.Get("name"),
 		"tags": d.Get("tags"),
@@ -118,7 +192,7 @@ func resourceDigitalOceanKubernetesNodePoolUpdate(ctx context.Context, d *schema
 	_, newTaint := d.GetChange("taint")
 	rawPool["taint"] = newTaint
 
-	timeout := d.Timeout(schema.TimeoutCreate)
+	timeout := d.Timeout(schema.TimeoutUpdate)
 	_, err := digitaloceanKubernetesNodePoolUpdate(client, timeout, rawPool, d.Get("cluster_id").(string), d.Id())
 	if err != nil {
 		return diag.Errorf("Error updating node pool: %s", err)
@@ -134,7 +208,7 @@ func resourceDigitalOceanKubernetesNodePoolDelete(ctx context.Context, d *schema
 		return diag.Errorf("Unable to delete node pool %s", err)
 	}
 
-	err = waitForKubernetesNodePoolDelete(client, d)
+	err = waitForKubernetesNodePoolDelete(client, d.Timeout(schema.TimeoutDelete), d.Get("cluster_id").(string), d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -142,7 +216,167 @@ func resourceDigitalOceanKubernetesNodePoolDelete(ctx context.Context, d *schema
 	return nil
 }
 
+// resourceDigitalOceanKubernetesNodePoolBlueGreenReplace replaces the node pool
+// with a sibling pool using the new configuration. The old pool is cordoned
+// with a taint and only deleted after the drain period has passed, so that
+// workloads can move to the new nodes before the old ones go away. The
+// resource keeps tracking the old pool until it has been deleted; if any step
+// before that fails, the new pool is deleted and the old one uncordoned.
+func resourceDigitalOceanKubernetesNodePoolBlueGreenReplace(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+	oldPoolID := d.Id()
+	name := d.Get("name").(string)
+
+	oldPool, _, err := client.Kubernetes.GetNodePool(context.Background(), clusterID, oldPoolID)
+	if err != nil {
+		return diag.Errorf("Error retrieving Kubernetes node pool: %s", err)
+	}
+
+	// Node pool names must be unique within a cluster, so the new pool is
+	// created under a temporary name and renamed once the old one is gone.
+	pools, _, err := client.Kubernetes.ListNodePools(context.Background(), clusterID, nil)
+	if err != nil {
+		return diag.Errorf("Error retrieving Kubernetes node pools: %s", err)
+	}
+	temporaryName, err := nodePoolReplacementName(name, pools)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rawPool := map[string]interface{}{
+		"name":       temporaryName,
+		"size":       d.Get("size"),
+		"tags":       d.Get("tags"),
+		"labels":     d.Get("labels"),
+		"node_count": d.Get("node_count"),
+		"auto_scale": d.Get("auto_scale"),
+		"min_nodes":  d.Get("min_nodes"),
+		"max_nodes":  d.Get("max_nodes"),
+		"taint":      d.Get("taint"),
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	log.Printf("[INFO] Creating replacement for Kubernetes node pool %s", oldPoolID)
+	newPool, err := digitaloceanKubernetesNodePoolCreate(client, timeout, rawPool, clusterID)
+	if err != nil {
+		if newPool != nil {
+			return abortKubernetesNodePoolReplacement(client, timeout, clusterID, oldPool, newPool.ID,
+				fmt.Errorf("Error creating replacement Kubernetes node pool: %s", err))
+		}
+		return diag.Errorf("Error creating replacement Kubernetes node pool: %s", err)
+	}
+
+	log.Printf("[INFO] Cordoning Kubernetes node pool %s", oldPoolID)
+	taints := append(append([]godo.Taint{}, oldPool.Taints...), godo.Taint{
+		Key:    nodePoolReplacedTaintKey,
+		Value:  newPool.ID,
+		Effect: "NoSchedule",
+	})
+	_, _, err = client.Kubernetes.UpdateNodePool(context.Background(), clusterID, oldPoolID, &godo.KubernetesNodePoolUpdateRequest{
+		Name:   oldPool.Name,
+		Taints: &taints,
+	})
+	if err != nil {
+		return abortKubernetesNodePoolReplacement(client, timeout, clusterID, oldPool, newPool.ID,
+			fmt.Errorf("Error cordoning Kubernetes node pool %s: %s", oldPoolID, err))
+	}
+
+	drainPeriod := time.Duration(d.Get("drain_period_seconds").(int)) * time.Second
+	log.Printf("[INFO] Waiting %s for workloads to drain from Kubernetes node pool %s", drainPeriod, oldPoolID)
+	select {
+	case <-ctx.Done():
+		return abortKubernetesNodePoolReplacement(client, timeout, clusterID, oldPool, newPool.ID,
+			fmt.Errorf("Error waiting for Kubernetes node pool %s to drain: %s", oldPoolID, ctx.Err()))
+	case <-time.After(drainPeriod):
+	}
+
+	_, err = client.Kubernetes.DeleteNodePool(context.Background(), clusterID, oldPoolID)
+	if err != nil {
+		return abortKubernetesNodePoolReplacement(client, timeout, clusterID, oldPool, newPool.ID,
+			fmt.Errorf("Error deleting Kubernetes node pool %s: %s", oldPoolID, err))
+	}
+
+	// The old pool is being deleted and can no longer be rolled back to, so
+	// the resource tracks the new pool from here on even if waiting fails.
+	err = waitForKubernetesNodePoolDelete(client, timeout, clusterID, oldPoolID)
+	d.SetId(newPool.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, _, err = client.Kubernetes.UpdateNodePool(context.Background(), clusterID, newPool.ID, &godo.KubernetesNodePoolUpdateRequest{
+		Name: name,
+	})
+	if err != nil {
+		return diag.Errorf("Error renaming Kubernetes node pool %s: %s", newPool.ID, err)
+	}
+
+	return resourceDigitalOceanKubernetesNodePoolRead(ctx, d, meta)
+}
+
+// abortKubernetesNodePoolReplacement rolls back a blue/green replacement that
+// failed before the old pool was deleted: the new pool is deleted and the
+// taints of the old pool are restored.
+func abortKubernetesNodePoolReplacement(client *godo.Client, timeout time.Duration, clusterID string, oldPool *godo.KubernetesNodePool, newPoolID string, cause error) diag.Diagnostics {
+	diags := diag.FromErr(cause)
+
+	log.Printf("[INFO] Deleting replacement Kubernetes node pool %s", newPoolID)
+	_, err := client.Kubernetes.DeleteNodePool(context.Background(), clusterID, newPoolID)
+	if err == nil {
+		err = waitForKubernetesNodePoolDelete(client, timeout, clusterID, newPoolID)
+	}
+	if err != nil {
+		diags = append(diags, diag.Errorf("Error deleting replacement Kubernetes node pool %s, it must be deleted manually: %s", newPoolID, err)...)
+	}
+
+	taints := append([]godo.Taint{}, oldPool.Taints...)
+	_, _, err = client.Kubernetes.UpdateNodePool(context.Background(), clusterID, oldPool.ID, &godo.KubernetesNodePoolUpdateRequest{
+		Name:   oldPool.Name,
+		Taints: &taints,
+	})
+	if err != nil {
+		diags = append(diags, diag.Errorf("Error restoring the taints of Kubernetes node pool %s: %s", oldPool.ID, err)...)
+	}
+
+	return diags
+}
+
+// nodePoolReplacementName returns a temporary name for the replacement of a
+// node pool that is not used by any other pool of the cluster. The name of a
+// node pool is used as the value of the doks.digitalocean.com/node-pool label
+// of its nodes, so it is kept within the length limit of label values.
+func nodePoolReplacementName(name string, pools []*godo.KubernetesNodePool) (string, error) {
+	used := make(map[string]bool, len(pools))
+	for _, pool := range pools {
+		used[pool.Name] = true
+	}
+
+	prefix := name
+	if maxPrefix := nodePoolNameMaxLength - nodePoolReplacementSuffixLength - 1; len(prefix) > maxPrefix {
+		prefix = strings.TrimRight(prefix[:maxPrefix], "-")
+	}
+
+	for i := 0; i < 10; i++ {
+		suffix := make([]byte, nodePoolReplacementSuffixLength/2)
+		if _, err := rand.Read(suffix); err != nil {
+			return "", fmt.Errorf("Error generating a name for the replacement node pool: %s", err)
+		}
+
+		candidate := fmt.Sprintf("%s-%s", prefix, hex.EncodeToString(suffix))
+		if !used[candidate] {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("Error generating a unique name for the replacement of node pool %s", name)
+}
+
 func resourceDigitalOceanKubernetesNodePoolImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("replacement_strategy", NodePoolReplacementStrategyRecreate)
+	d.Set("drain_period_seconds", 300)
+
 	if _, ok := d.GetOk("cluster_id"); ok {
 		// Short-circuit: The resource already has a cluster ID, no need to search for it.
 		return []*schema.ResourceData{d}, nil
@@ -229,7 +463,8 @@ func digitaloceanKubernetesNodePoolCreate(client *godo.Client, timeout time.Dura
 
 	err = waitForKubernetesNodePoolCreate(client, timeout, clusterID, p.ID)
 	if err != nil {
-		return nil, err
+		// The pool was created, return it so the caller can clean it up.
+		return p, err
 	}
 
 	return p, nil
@@ -282,7 +517,8 @@ func digitaloceanKubernetesNodePoolUpdate(client *godo.Client, timeout time.Dura
 
 	err = waitForKubernetesNodePoolCreate(client, timeout, clusterID, p.ID)
 	if err != nil {
-		return nil, err
+		// The pool was created, return it so the caller can clean it up.
+		return p, err
 	}
 
 	return p, nil
@@ -327,17 +563,17 @@ func waitForKubernetesNodePoolCreate(client *godo.Client, duration time.Duration
 	return fmt.Errorf("Timeout waiting to create nodepool")
 }
 
-func waitForKubernetesNodePoolDelete(client *godo.Client, d *schema.ResourceData) error {
+func waitForKubernetesNodePoolDelete(client *godo.Client, duration time.Duration, clusterID, poolID string) error {
 	var (
 		tickerInterval = 10 * time.Second
-		timeoutSeconds = d.Timeout(schema.TimeoutDelete).Seconds()
+		timeoutSeconds = duration.Seconds()
 		timeout        = int(timeoutSeconds / tickerInterval.Seconds())
 		n              = 0
 		ticker         = time.NewTicker(tickerInterval)
 	)
 
 	for range ticker.C {
-		_, resp, err := client.Kubernetes.GetNodePool(context.Background(), d.Get("cluster_id").(string), d.Id())
+		_, resp, err := client.Kubernetes.GetNodePool(context.Background(), clusterID, poolID)
 		if err != nil {
 			ticker.Stop()
 
@@ -0,0 +1,246 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceDigitalOceanKubernetesNodePoolRecycle replaces nodes in an existing
+// node pool. The replacement is performed on create, so any change to its
+// arguments (most commonly `triggers`) results in another round of recycling.
+func ResourceDigitalOceanKubernetesNodePoolRecycle() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanKubernetesNodePoolRecycleCreate,
+		ReadContext:   resourceDigitalOceanKubernetesNodePoolRecycleRead,
+		DeleteContext: resourceDigitalOceanKubernetesNodePoolRecycleDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"node_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"node_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"max_unavailable": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"replace": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"skip_drain": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"recycled_node_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+func resourceDigitalOceanKubernetesNodePoolRecycleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+	poolID := d.Get("node_pool_id").(string)
+
+	pool, _, err := client.Kubernetes.GetNodePool(context.Background(), clusterID, poolID)
+	if err != nil {
+		return diag.Errorf("Error retrieving Kubernetes node pool: %s", err)
+	}
+
+	nodeIDs, err := nodePoolRecycleTargets(pool, d.Get("node_ids").(*schema.Set).List())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := &godo.KubernetesNodeDeleteRequest{
+		Replace:   d.Get("replace").(bool),
+		SkipDrain: d.Get("skip_drain").(bool),
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	for _, batch := range chunkNodeIDs(nodeIDs, d.Get("max_unavailable").(int)) {
+		for _, nodeID := range batch {
+			log.Printf("[INFO] Recycling node %s in Kubernetes node pool %s", nodeID, poolID)
+			// RecycleNodePoolNodes is deprecated in favor of DeleteNode, which
+			// also allows controlling draining and replacement per node.
+			_, err := client.Kubernetes.DeleteNode(context.Background(), clusterID, poolID, nodeID, req)
+			if err != nil {
+				return diag.Errorf("Error recycling node %s in Kubernetes node pool %s: %s", nodeID, poolID, err)
+			}
+		}
+
+		err = waitForKubernetesNodePoolRecycle(client, timeout, clusterID, poolID, batch)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(id.PrefixedUniqueId(poolID + "-"))
+	d.Set("recycled_node_ids", nodeIDs)
+
+	return resourceDigitalOceanKubernetesNodePoolRecycleRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanKubernetesNodePoolRecycleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	_, resp, err := client.Kubernetes.GetNodePool(context.Background(), d.Get("cluster_id").(string), d.Get("node_pool_id").(string))
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			log.Printf("[WARN] Kubernetes node pool (%s) not found", d.Get("node_pool_id").(string))
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving Kubernetes node pool: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanKubernetesNodePoolRecycleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Recycling nodes can not be undone, so there is nothing to do here.
+	d.SetId("")
+	return nil
+}
+
+// nodePoolRecycleTargets returns the IDs of the nodes that should be recycled.
+// When no IDs were requested, every node in the pool is returned.
+func nodePoolRecycleTargets(pool *godo.KubernetesNodePool, requested []interface{}) ([]string, error) {
+	inPool := make(map[string]bool, len(pool.Nodes))
+	all := make([]string, 0, len(pool.Nodes))
+	for _, node := range pool.Nodes {
+		inPool[node.ID] = true
+		all = append(all, node.ID)
+	}
+
+	if len(requested) == 0 {
+		return all, nil
+	}
+
+	nodeIDs := make([]string, 0, len(requested))
+	for _, raw := range requested {
+		nodeID := raw.(string)
+		if !inPool[nodeID] {
+			return nil, fmt.Errorf("Node %s does not belong to Kubernetes node pool %s", nodeID, pool.ID)
+		}
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+
+	return nodeIDs, nil
+}
+
+// chunkNodeIDs splits the node IDs into batches of at most size elements.
+func chunkNodeIDs(nodeIDs []string, size int) [][]string {
+	if size < 1 {
+		size = 1
+	}
+
+	var batches [][]string
+	for start := 0; start < len(nodeIDs); start += size {
+		end := start + size
+		if end > len(nodeIDs) {
+			end = len(nodeIDs)
+		}
+		batches = append(batches, nodeIDs[start:end])
+	}
+
+	return batches
+}
+
+// waitForKubernetesNodePoolRecycle waits until the recycled nodes have left the
+// pool and the pool's actual node count has recovered with all nodes running.
+func waitForKubernetesNodePoolRecycle(client *godo.Client, duration time.Duration, clusterID, poolID string, recycled []string) error {
+	var (
+		tickerInterval = 10 * time.Second
+		timeoutSeconds = duration.Seconds()
+		timeout        = int(timeoutSeconds / tickerInterval.Seconds())
+		n              = 0
+	)
+
+	pending := make(map[string]bool, len(recycled))
+	for _, nodeID := range recycled {
+		pending[nodeID] = true
+	}
+
+	ticker := time.NewTicker(tickerInterval)
+	for range ticker.C {
+		pool, _, err := client.Kubernetes.GetNodePool(context.Background(), clusterID, poolID)
+		if err != nil {
+			ticker.Stop()
+			return fmt.Errorf("Error trying to read nodepool state: %s", err)
+		}
+
+		recovered := len(pool.Nodes) == pool.Count
+		for _, node := range pool.Nodes {
+			if pending[node.ID] || node.Status == nil || node.Status.State != "running" {
+				recovered = false
+			}
+		}
+
+		if recovered {
+			ticker.Stop()
+			return nil
+		}
+
+		if n > timeout {
+			ticker.Stop()
+			break
+		}
+
+		n++
+	}
+
+	return fmt.Errorf("Timeout waiting for nodes in nodepool %s to be recycled", poolID)
+}
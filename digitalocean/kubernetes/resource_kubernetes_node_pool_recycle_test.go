@@ -0,0 +1,81 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanKubernetesNodePoolRecycle_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+	var k8sPool godo.KubernetesNodePool
+
+	baseConfig := fmt.Sprintf(`%s
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "lon1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+
+resource "digitalocean_kubernetes_node_pool" "barfoo" {
+  cluster_id = digitalocean_kubernetes_cluster.foobar.id
+  name       = "%s"
+  size       = "s-1vcpu-2gb"
+  node_count = 2
+}
+`, testClusterVersionLatest, rName, rName)
+
+	recycleConfig := `
+resource "digitalocean_kubernetes_node_pool_recycle" "foobar" {
+  cluster_id      = digitalocean_kubernetes_cluster.foobar.id
+  node_pool_id    = digitalocean_kubernetes_node_pool.barfoo.id
+  max_unavailable = 1
+
+  triggers = {
+    rotation = "%s"
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: baseConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
+				),
+			},
+			{
+				Config: baseConfig + fmt.Sprintf(recycleConfig, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool_recycle.foobar", "recycled_node_ids.#", "2"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool_recycle.foobar", "replace", "true"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool_recycle.foobar", "skip_drain", "false"),
+					resource.TestCheckResourceAttrSet("digitalocean_kubernetes_node_pool_recycle.foobar", "recycled_node_ids.0"),
+				),
+			},
+			{
+				Config: baseConfig + fmt.Sprintf(recycleConfig, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool_recycle.foobar", "triggers.rotation", "second"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool_recycle.foobar", "recycled_node_ids.#", "2"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "actual_node_count", "2"),
+				),
+			},
+		},
+	})
+}
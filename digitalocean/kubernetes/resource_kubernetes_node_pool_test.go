@@ -71,6 +71,9 @@ resource "digitalocean_kubernetes_cluster" "foobar" {
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_cluster.foobar", "name", rName),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "name", rName),
 					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "taint.#", "0"),
+					resource.TestCheckResourceAttrSet("digitalocean_kubernetes_node_pool.barfoo", "price_monthly"),
+					resource.TestCheckResourceAttrSet("digitalocean_kubernetes_node_pool.barfoo", "price_hourly"),
+					resource.TestCheckResourceAttrSet("digitalocean_kubernetes_cluster.foobar", "node_pool.0.price_monthly"),
 				),
 			},
 			// Update: add taint
@@ -212,6 +215,81 @@ func TestAccDigitalOceanKubernetesNodePool_Update(t *testing.T) {
 	})
 }
 
+func TestAccDigitalOceanKubernetesNodePool_BlueGreenResize(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+	var k8sPool godo.KubernetesNodePool
+
+	clusterConfig := fmt.Sprintf(`%s
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "lon1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+`, testClusterVersionLatest, rName)
+
+	nodePoolConfig := `resource "digitalocean_kubernetes_node_pool" "barfoo" {
+  cluster_id = digitalocean_kubernetes_cluster.foobar.id
+
+  name                 = "%s"
+  size                 = "%s"
+  node_count           = 1
+  replacement_strategy = "blue_green"
+  drain_period_seconds = 30
+
+  labels = {
+    priority = "high"
+  }
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: clusterConfig + fmt.Sprintf(nodePoolConfig, rName, "s-1vcpu-2gb"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "size", "s-1vcpu-2gb"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "replacement_strategy", "blue_green"),
+				),
+			},
+			{
+				Config: clusterConfig + fmt.Sprintf(nodePoolConfig, rName, "s-2vcpu-2gb"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesNodePoolExists("digitalocean_kubernetes_node_pool.barfoo", &k8s, &k8sPool),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "name", rName),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "size", "s-2vcpu-2gb"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "labels.priority", "high"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "actual_node_count", "1"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_node_pool.barfoo", "taint.#", "0"),
+					func(s *terraform.State) error {
+						client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+						pools, _, err := client.Kubernetes.ListNodePools(context.Background(), k8s.ID, nil)
+						if err != nil {
+							return err
+						}
+						// The default pool and the replacement pool.
+						if len(pools) != 2 {
+							return fmt.Errorf("expected 2 node pools after replacement, found %d", len(pools))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccDigitalOceanKubernetesNodePool_CreateWithAutoScale(t *testing.T) {
 	rName := acceptance.RandomTestName()
 	var k8s godo.KubernetesCluster
@@ -0,0 +1,129 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanKubernetesRegistryAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanKubernetesRegistryAttachmentCreate,
+		ReadContext:   resourceDigitalOceanKubernetesRegistryAttachmentRead,
+		DeleteContext: resourceDigitalOceanKubernetesRegistryAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDigitalOceanKubernetesRegistryAttachmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"registry_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanKubernetesRegistryAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+	registryName := d.Get("registry_name").(string)
+
+	err := addKubernetesRegistries(client, clusterID, []string{registryName})
+	if err != nil {
+		return diag.Errorf("Error attaching container registry %s to Kubernetes cluster %s: %s", registryName, clusterID, err)
+	}
+
+	d.SetId(makeKubernetesRegistryAttachmentID(clusterID, registryName))
+
+	return resourceDigitalOceanKubernetesRegistryAttachmentRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanKubernetesRegistryAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+	registryName := d.Get("registry_name").(string)
+
+	// The API only reports whether registries are integrated with a cluster,
+	// not which ones, so the attachment is considered gone once either side no
+	// longer exists or the cluster has no registries left.
+	cluster, resp, err := client.Kubernetes.Get(context.Background(), clusterID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Kubernetes cluster (%s) not found", clusterID)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving Kubernetes cluster: %s", err)
+	}
+
+	if !cluster.RegistryEnabled {
+		log.Printf("[WARN] Container registry (%s) is not attached to Kubernetes cluster (%s)", registryName, clusterID)
+		d.SetId("")
+		return nil
+	}
+
+	_, resp, err = client.Registries.Get(context.Background(), registryName)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Container registry (%s) not found", registryName)
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving container registry: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanKubernetesRegistryAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+	registryName := d.Get("registry_name").(string)
+
+	err := removeKubernetesRegistries(client, clusterID, []string{registryName})
+	if err != nil {
+		return diag.Errorf("Error detaching container registry %s from Kubernetes cluster %s: %s", registryName, clusterID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDigitalOceanKubernetesRegistryAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), ",") {
+		s := strings.Split(d.Id(), ",")
+		d.SetId(makeKubernetesRegistryAttachmentID(s[0], s[1]))
+		d.Set("cluster_id", s[0])
+		d.Set("registry_name", s[1])
+	} else {
+		return nil, errors.New("must use the ID of the Kubernetes cluster and the name of the container registry joined with a comma (e.g. `id,name`)")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func makeKubernetesRegistryAttachmentID(clusterID string, registryName string) string {
+	return fmt.Sprintf("%s/registry/%s", clusterID, registryName)
+}
@@ -0,0 +1,79 @@
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanKubernetesRegistryAttachment_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+	var k8s godo.KubernetesCluster
+
+	resourceName := "digitalocean_kubernetes_registry_attachment.foobar"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDigitalOceanKubernetesConfigRegistryAttachment(testClusterVersionLatest, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDigitalOceanKubernetesClusterExists("digitalocean_kubernetes_cluster.foobar", &k8s),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_id", "digitalocean_kubernetes_cluster.foobar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "registry_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccKubernetesRegistryAttachmentImportID(resourceName),
+			},
+		},
+	})
+}
+
+func testAccKubernetesRegistryAttachmentImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes["registry_name"]), nil
+	}
+}
+
+func testAccDigitalOceanKubernetesConfigRegistryAttachment(testClusterVersion string, rName string) string {
+	return fmt.Sprintf(`%s
+
+resource "digitalocean_container_registry" "foobar" {
+  name                   = "%s"
+  region                 = "nyc3"
+  subscription_tier_slug = "starter"
+}
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "nyc3"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-1vcpu-2gb"
+    node_count = 1
+  }
+}
+
+resource "digitalocean_kubernetes_registry_attachment" "foobar" {
+  cluster_id    = digitalocean_kubernetes_cluster.foobar.id
+  registry_name = digitalocean_container_registry.foobar.name
+}
+`, testClusterVersion, rName, rName)
+}
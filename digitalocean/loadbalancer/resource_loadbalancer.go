@@ -86,7 +86,7 @@ func ResourceDigitalOceanLoadbalancer() *schema.Resource {
 				return err
 			}
 
-			return nil
+			return customizeDiffLoadBalancerPrice(ctx, diff, v)
 		},
 	}
 }
@@ -115,9 +115,49 @@ func resourceDigitalOceanLoadBalancerV1() map[string]*schema.Schema {
 	}
 	loadBalancerV1Schema["forwarding_rule"].Elem.(*schema.Resource).Schema = forwardingRuleSchema
 
+	loadBalancerV1Schema["price_monthly"] = &schema.Schema{
+		Type:        schema.TypeFloat,
+		Computed:    true,
+		Description: "The estimated monthly price of the load balancer in USD, based on a hardcoded list price",
+	}
+	loadBalancerV1Schema["price_hourly"] = &schema.Schema{
+		Type:        schema.TypeFloat,
+		Computed:    true,
+		Description: "The estimated hourly price of the load balancer in USD, based on a hardcoded list price",
+	}
+
 	return loadBalancerV1Schema
 }
 
+// customizeDiffLoadBalancerPrice shows the price of the load balancer in the
+// plan when its size changes. The price of load balancers relying on the
+// default size is only known once they have been created.
+func customizeDiffLoadBalancerPrice(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("size", "size_unit") {
+		return nil
+	}
+
+	if !d.NewValueKnown("type") || !d.NewValueKnown("size") || !d.NewValueKnown("size_unit") {
+		d.SetNewComputed("price_monthly")
+		d.SetNewComputed("price_hourly")
+		return nil
+	}
+
+	price, err := meta.(*config.CombinedConfig).PriceCatalog().LoadBalancer(d.Get("type").(string), d.Get("size_unit").(int), d.Get("size").(string))
+	if err != nil {
+		log.Printf("[WARN] Unable to determine the price of load balancer: %s", err)
+		d.SetNewComputed("price_monthly")
+		d.SetNewComputed("price_hourly")
+		return nil
+	}
+
+	if err := d.SetNew("price_monthly", price.Monthly); err != nil {
+		return err
+	}
+
+	return d.SetNew("price_hourly", price.Hourly)
+}
+
 func loadbalancerDiffCheck(ctx context.Context, d *schema.ResourceDiff, v interface{}) error {
 	typ, typSet := d.GetOk("type")
 	region, regionSet := d.GetOk("region")
@@ -786,6 +826,13 @@ func resourceDigitalOceanLoadbalancerRead(ctx context.Context, d *schema.Resourc
 		d.Set("size", loadbalancer.SizeSlug)
 	}
 
+	price, err := meta.(*config.CombinedConfig).PriceCatalog().LoadBalancer(loadbalancer.Type, int(loadbalancer.SizeUnit), loadbalancer.SizeSlug)
+	if err != nil {
+		log.Printf("[WARN] Unable to determine the price of load balancer (%s): %s", loadbalancer.ID, err)
+	}
+	d.Set("price_monthly", price.Monthly)
+	d.Set("price_hourly", price.Hourly)
+
 	if loadbalancer.Region != nil {
 		d.Set("region", loadbalancer.Region.Slug)
 	}
@@ -0,0 +1,81 @@
+package oneclick
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	oneClickTypeDroplet    = "droplet"
+	oneClickTypeKubernetes = "kubernetes"
+)
+
+func DataSourceDigitalOceanOneClickApps() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema: map[string]*schema.Schema{
+			"slug": {
+				Type:        schema.TypeString,
+				Description: "slug of the 1-Click application",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Description: "type of the 1-Click application, either droplet or kubernetes",
+			},
+		},
+		ResultAttributeName: "apps",
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					oneClickTypeDroplet,
+					oneClickTypeKubernetes,
+				}, false),
+			},
+		},
+		FlattenRecord: flattenDigitalOceanOneClickApp,
+		GetRecords:    getDigitalOceanOneClickApps,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func getDigitalOceanOneClickApps(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	types := []string{oneClickTypeDroplet, oneClickTypeKubernetes}
+	if t, ok := extra["type"].(string); ok && t != "" {
+		types = []string{t}
+	}
+
+	appsList := []interface{}{}
+	for _, t := range types {
+		apps, _, err := client.OneClick.List(context.Background(), t)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving %s 1-Click applications: %s", t, err)
+		}
+
+		for _, app := range apps {
+			appsList = append(appsList, *app)
+		}
+	}
+
+	return appsList, nil
+}
+
+func flattenDigitalOceanOneClickApp(rawApp, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	app := rawApp.(godo.OneClick)
+
+	flattenedApp := map[string]interface{}{
+		"slug": app.Slug,
+		"type": app.Type,
+	}
+
+	return flattenedApp, nil
+}
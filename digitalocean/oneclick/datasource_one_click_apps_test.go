@@ -0,0 +1,51 @@
+package oneclick_test
+
+import (
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDigitalOceanOneClickApps_Basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDigitalOceanOneClickAppsConfig_kubernetes,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.digitalocean_one_click_apps.foobar", "apps.#", "1"),
+					resource.TestCheckResourceAttr("data.digitalocean_one_click_apps.foobar", "apps.0.slug", "monitoring"),
+					resource.TestCheckResourceAttr("data.digitalocean_one_click_apps.foobar", "apps.0.type", "kubernetes"),
+				),
+			},
+			{
+				Config: testAccCheckDataSourceDigitalOceanOneClickAppsConfig_droplet,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.digitalocean_one_click_apps.foobar", "apps.0.slug"),
+					resource.TestCheckResourceAttr("data.digitalocean_one_click_apps.foobar", "apps.0.type", "droplet"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckDataSourceDigitalOceanOneClickAppsConfig_kubernetes = `
+data "digitalocean_one_click_apps" "foobar" {
+  type = "kubernetes"
+
+  filter {
+    key    = "slug"
+    values = ["monitoring"]
+  }
+}`
+
+const testAccCheckDataSourceDigitalOceanOneClickAppsConfig_droplet = `
+data "digitalocean_one_click_apps" "foobar" {
+  type = "droplet"
+
+  sort {
+    key = "slug"
+  }
+}`
@@ -0,0 +1,155 @@
+package oneclick
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/util"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceDigitalOceanKubernetesOneClick() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanKubernetesOneClickCreate,
+		ReadContext:   resourceDigitalOceanKubernetesOneClickRead,
+		UpdateContext: resourceDigitalOceanKubernetesOneClickUpdate,
+		DeleteContext: resourceDigitalOceanKubernetesOneClickDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"slugs": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+		},
+	}
+}
+
+func resourceDigitalOceanKubernetesOneClickCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	clusterID := d.Get("cluster_id").(string)
+	slugs := expandSlugs(d.Get("slugs").(*schema.Set))
+
+	err := installKubernetesOneClickApps(client, clusterID, slugs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.PrefixedUniqueId(clusterID + "-"))
+
+	return resourceDigitalOceanKubernetesOneClickRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanKubernetesOneClickRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	// The API does not report which 1-Click applications are installed in a
+	// cluster, so only the existence of the cluster itself is verified.
+	_, resp, err := client.Kubernetes.Get(context.Background(), d.Get("cluster_id").(string))
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Kubernetes cluster (%s) not found", d.Get("cluster_id").(string))
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving Kubernetes cluster: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanKubernetesOneClickUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	var diags diag.Diagnostics
+	if d.HasChange("slugs") {
+		old, new := d.GetChange("slugs")
+		remove, add := util.GetSetChanges(old.(*schema.Set), new.(*schema.Set))
+		added := expandSlugs(add)
+		removed := expandSlugs(remove)
+
+		if len(added) > 0 {
+			err := installKubernetesOneClickApps(client, d.Get("cluster_id").(string), added)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if len(removed) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "1-Click applications are not uninstalled",
+				Detail:   fmt.Sprintf("The following 1-Click applications were removed from the configuration but remain installed in the cluster: %v", removed),
+			})
+		}
+	}
+
+	return append(diags, resourceDigitalOceanKubernetesOneClickRead(ctx, d, meta)...)
+}
+
+func resourceDigitalOceanKubernetesOneClickDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The API does not support uninstalling 1-Click applications, so they are
+	// only removed from the Terraform state.
+	log.Printf("[WARN] 1-Click applications %v remain installed in Kubernetes cluster %s",
+		expandSlugs(d.Get("slugs").(*schema.Set)), d.Get("cluster_id").(string))
+	d.SetId("")
+	return nil
+}
+
+func installKubernetesOneClickApps(client *godo.Client, clusterID string, slugs []string) error {
+	available, _, err := client.OneClick.List(context.Background(), oneClickTypeKubernetes)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Kubernetes 1-Click applications: %s", err)
+	}
+
+	known := make(map[string]bool, len(available))
+	for _, app := range available {
+		known[app.Slug] = true
+	}
+
+	sort.Strings(slugs)
+	for _, slug := range slugs {
+		if !known[slug] {
+			return fmt.Errorf("%s is not an available Kubernetes 1-Click application", slug)
+		}
+	}
+
+	log.Printf("[INFO] Installing 1-Click applications %v in Kubernetes cluster %s", slugs, clusterID)
+	_, _, err = client.OneClick.InstallKubernetes(context.Background(), &godo.InstallKubernetesAppsRequest{
+		Slugs:       slugs,
+		ClusterUUID: clusterID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error installing 1-Click applications in Kubernetes cluster %s: %s", clusterID, err)
+	}
+
+	return nil
+}
+
+func expandSlugs(set *schema.Set) []string {
+	slugs := make([]string, 0, set.Len())
+	for _, slug := range set.List() {
+		slugs = append(slugs, slug.(string))
+	}
+	return slugs
+}
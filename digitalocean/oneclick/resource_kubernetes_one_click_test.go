@@ -0,0 +1,70 @@
+package oneclick_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanKubernetesOneClick_Basic(t *testing.T) {
+	rName := acceptance.RandomTestName()
+
+	clusterConfig := fmt.Sprintf(`
+data "digitalocean_kubernetes_versions" "test" {
+}
+
+resource "digitalocean_kubernetes_cluster" "foobar" {
+  name    = "%s"
+  region  = "lon1"
+  version = data.digitalocean_kubernetes_versions.test.latest_version
+
+  node_pool {
+    name       = "default"
+    size       = "s-2vcpu-4gb"
+    node_count = 1
+  }
+}
+`, rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: clusterConfig + `
+resource "digitalocean_kubernetes_one_click" "foobar" {
+  cluster_id = digitalocean_kubernetes_cluster.foobar.id
+  slugs      = ["monitoring"]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("digitalocean_kubernetes_one_click.foobar", "cluster_id", "digitalocean_kubernetes_cluster.foobar", "id"),
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_one_click.foobar", "slugs.#", "1"),
+				),
+			},
+			{
+				Config: clusterConfig + `
+resource "digitalocean_kubernetes_one_click" "foobar" {
+  cluster_id = digitalocean_kubernetes_cluster.foobar.id
+  slugs      = ["monitoring", "cert-manager"]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("digitalocean_kubernetes_one_click.foobar", "slugs.#", "2"),
+				),
+			},
+			{
+				Config: clusterConfig + `
+resource "digitalocean_kubernetes_one_click" "foobar" {
+  cluster_id = digitalocean_kubernetes_cluster.foobar.id
+  slugs      = ["not-a-real-1-click"]
+}
+`,
+				ExpectError: regexp.MustCompile("is not an available Kubernetes 1-Click application"),
+			},
+		},
+	})
+}
@@ -0,0 +1,210 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/digitalocean/godo"
+)
+
+// ErrPriceNotFound is returned when a size is not listed in the catalog.
+var ErrPriceNotFound = errors.New("price not found")
+
+// Price is the cost of a resource in USD.
+type Price struct {
+	Monthly float64
+	Hourly  float64
+}
+
+// Add returns the sum of two prices.
+func (p Price) Add(other Price) Price {
+	return Price{
+		Monthly: p.Monthly + other.Monthly,
+		Hourly:  p.Hourly + other.Hourly,
+	}
+}
+
+// Times returns the price of n resources of the same size.
+func (p Price) Times(n int) Price {
+	return Price{
+		Monthly: p.Monthly * float64(n),
+		Hourly:  p.Hourly * float64(n),
+	}
+}
+
+// Rounded rounds the monthly price to cents and the hourly price to the
+// precision used by the sizes catalog, removing floating point noise from
+// sums.
+func (p Price) Rounded() Price {
+	return Price{
+		Monthly: math.Round(p.Monthly*100) / 100,
+		Hourly:  math.Round(p.Hourly*100000) / 100000,
+	}
+}
+
+// Catalog looks up prices from the size catalogs of the API. Each catalog is
+// retrieved at most once, so a single Catalog is shared by all of the
+// resources of a Terraform run.
+type Catalog struct {
+	client *godo.Client
+
+	mu               sync.Mutex
+	dropletSizes     map[string]Price
+	appInstanceSizes map[string]Price
+}
+
+func NewCatalog(client *godo.Client) *Catalog {
+	return &Catalog{client: client}
+}
+
+// DropletSize returns the price of a Droplet size, which is also the price of
+// a node of a Kubernetes node pool using that size.
+func (c *Catalog) DropletSize(ctx context.Context, slug string) (Price, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.dropletSizes == nil {
+		sizes, err := c.listDropletSizes(ctx)
+		if err != nil {
+			return Price{}, err
+		}
+		c.dropletSizes = sizes
+	}
+
+	price, ok := c.dropletSizes[slug]
+	if !ok {
+		return Price{}, fmt.Errorf("%w for size %s", ErrPriceNotFound, slug)
+	}
+
+	return price, nil
+}
+
+// AppInstanceSize returns the price of a single instance of an App Platform
+// component using the instance size.
+func (c *Catalog) AppInstanceSize(ctx context.Context, slug string) (Price, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.appInstanceSizes == nil {
+		sizes, err := c.listAppInstanceSizes(ctx)
+		if err != nil {
+			return Price{}, err
+		}
+		c.appInstanceSizes = sizes
+	}
+
+	price, ok := c.appInstanceSizes[slug]
+	if !ok {
+		return Price{}, fmt.Errorf("%w for instance size %s", ErrPriceNotFound, slug)
+	}
+
+	return price, nil
+}
+
+// AppSpec returns the price of the services and workers of an app. An
+// autoscaled component is priced at its minimum instance count. Jobs, static
+// sites and functions are not included as they are not billed per instance.
+func (c *Catalog) AppSpec(ctx context.Context, spec *godo.AppSpec) (Price, error) {
+	var total Price
+	if spec == nil {
+		return total, nil
+	}
+
+	addComponent := func(slug string, count int64, autoscaling *godo.AppAutoscalingSpec) error {
+		if autoscaling != nil && autoscaling.MinInstanceCount > 0 {
+			count = autoscaling.MinInstanceCount
+		}
+		if count == 0 {
+			count = 1
+		}
+
+		price, err := c.AppInstanceSize(ctx, slug)
+		if err != nil {
+			return err
+		}
+
+		total = total.Add(price.Times(int(count)))
+		return nil
+	}
+
+	for _, service := range spec.Services {
+		if err := addComponent(service.InstanceSizeSlug, service.InstanceCount, service.Autoscaling); err != nil {
+			return Price{}, err
+		}
+	}
+
+	for _, worker := range spec.Workers {
+		if err := addComponent(worker.InstanceSizeSlug, worker.InstanceCount, worker.Autoscaling); err != nil {
+			return Price{}, err
+		}
+	}
+
+	return total.Rounded(), nil
+}
+
+func (c *Catalog) listDropletSizes(ctx context.Context) (map[string]Price, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	prices := make(map[string]Price)
+
+	for {
+		sizes, resp, err := c.client.Sizes.List(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
+		}
+
+		for _, size := range sizes {
+			prices[size.Slug] = Price{
+				Monthly: size.PriceMonthly,
+				Hourly:  size.PriceHourly,
+			}
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving sizes: %s", err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return prices, nil
+}
+
+func (c *Catalog) listAppInstanceSizes(ctx context.Context) (map[string]Price, error) {
+	sizes, _, err := c.client.Apps.ListInstanceSizes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving app instance sizes: %s", err)
+	}
+
+	prices := make(map[string]Price)
+	for _, size := range sizes {
+		monthly, err := strconv.ParseFloat(size.USDPerMonth, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing monthly price of app instance size %s: %s", size.Slug, err)
+		}
+
+		perSecond, err := strconv.ParseFloat(size.USDPerSecond, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing per second price of app instance size %s: %s", size.Slug, err)
+		}
+
+		prices[size.Slug] = Price{
+			Monthly: monthly,
+			Hourly:  perSecond * 3600,
+		}
+	}
+
+	return prices, nil
+}
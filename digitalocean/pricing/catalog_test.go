@@ -0,0 +1,171 @@
+package pricing_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/pricing"
+)
+
+func TestPrice_Rounded(t *testing.T) {
+	cases := []struct {
+		price    pricing.Price
+		expected pricing.Price
+	}{
+		{
+			price:    pricing.Price{},
+			expected: pricing.Price{},
+		},
+		{
+			price:    pricing.Price{Monthly: 0.1 + 0.2, Hourly: 0.00015 + 0.00030},
+			expected: pricing.Price{Monthly: 0.3, Hourly: 0.00045},
+		},
+		{
+			price:    pricing.Price{Monthly: 12.345, Hourly: 0.017857142},
+			expected: pricing.Price{Monthly: 12.35, Hourly: 0.01786},
+		},
+		{
+			price:    pricing.Price{Monthly: 12.344, Hourly: 0.017854},
+			expected: pricing.Price{Monthly: 12.34, Hourly: 0.01785},
+		},
+		{
+			price:    pricing.Price{Monthly: 4, Hourly: 0.00595}.Times(3),
+			expected: pricing.Price{Monthly: 12, Hourly: 0.01785},
+		},
+	}
+
+	for _, tc := range cases {
+		if rounded := tc.price.Rounded(); rounded != tc.expected {
+			t.Errorf("%+v.Rounded() = %+v, expected %+v", tc.price, rounded, tc.expected)
+		}
+	}
+}
+
+func TestCatalog_AppSpec(t *testing.T) {
+	requests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/apps/tiers/instance_sizes", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{"instance_sizes": [
+  {"slug": "size-a", "usd_per_month": "5.00", "usd_per_second": "0.000002"},
+  {"slug": "size-b", "usd_per_month": "12.00", "usd_per_second": "0.000005"}
+]}`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := godo.New(http.DefaultClient, godo.SetBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+	catalog := pricing.NewCatalog(client)
+
+	cases := []struct {
+		name     string
+		spec     *godo.AppSpec
+		expected pricing.Price
+		err      error
+	}{
+		{
+			name:     "no spec",
+			spec:     nil,
+			expected: pricing.Price{},
+		},
+		{
+			name: "services and workers",
+			spec: &godo.AppSpec{
+				Services: []*godo.AppServiceSpec{{InstanceSizeSlug: "size-a", InstanceCount: 2}},
+				Workers:  []*godo.AppWorkerSpec{{InstanceSizeSlug: "size-b"}},
+			},
+			expected: pricing.Price{Monthly: 22, Hourly: 0.0324},
+		},
+		{
+			name: "autoscaled components are priced at their minimum instance count",
+			spec: &godo.AppSpec{
+				Services: []*godo.AppServiceSpec{{
+					InstanceSizeSlug: "size-a",
+					Autoscaling:      &godo.AppAutoscalingSpec{MinInstanceCount: 3, MaxInstanceCount: 5},
+				}},
+			},
+			expected: pricing.Price{Monthly: 15, Hourly: 0.0216},
+		},
+		{
+			name: "jobs and static sites are not priced",
+			spec: &godo.AppSpec{
+				Jobs:        []*godo.AppJobSpec{{InstanceSizeSlug: "size-b"}},
+				StaticSites: []*godo.AppStaticSiteSpec{{Name: "web"}},
+			},
+			expected: pricing.Price{},
+		},
+		{
+			name: "unknown instance size",
+			spec: &godo.AppSpec{
+				Services: []*godo.AppServiceSpec{{InstanceSizeSlug: "size-c"}},
+			},
+			err: pricing.ErrPriceNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := catalog.AppSpec(context.Background(), tc.spec)
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("error = %v, expected %v", err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if price != tc.expected {
+				t.Errorf("price = %+v, expected %+v", price, tc.expected)
+			}
+		})
+	}
+
+	if requests != 1 {
+		t.Errorf("instance sizes were retrieved %d times, expected once", requests)
+	}
+}
+
+func TestCatalog_ListPrices(t *testing.T) {
+	catalog := pricing.NewCatalog(nil)
+
+	if price := catalog.Volume(100); price != (pricing.Price{Monthly: 10, Hourly: 0.015}) {
+		t.Errorf("Volume(100) = %+v", price)
+	}
+
+	if price := catalog.KubernetesHAControlPlane(); price != (pricing.Price{Monthly: 40, Hourly: 0.05952}) {
+		t.Errorf("KubernetesHAControlPlane() = %+v", price)
+	}
+
+	lbCases := []struct {
+		lbType   string
+		sizeUnit int
+		sizeSlug string
+		expected pricing.Price
+		err      error
+	}{
+		{lbType: "REGIONAL", sizeUnit: 2, expected: pricing.Price{Monthly: 24, Hourly: 0.03572}},
+		{lbType: "REGIONAL", sizeSlug: "lb-medium", expected: pricing.Price{Monthly: 36, Hourly: 0.05358}},
+		{lbType: "REGIONAL", sizeSlug: "lb-huge", err: pricing.ErrPriceNotFound},
+		{lbType: "GLOBAL", sizeUnit: 1, err: pricing.ErrPriceNotFound},
+	}
+	for _, tc := range lbCases {
+		price, err := catalog.LoadBalancer(tc.lbType, tc.sizeUnit, tc.sizeSlug)
+		if tc.err != nil {
+			if !errors.Is(err, tc.err) {
+				t.Errorf("LoadBalancer(%q, %d, %q) error = %v, expected %v", tc.lbType, tc.sizeUnit, tc.sizeSlug, err, tc.err)
+			}
+			continue
+		}
+		if err != nil || price != tc.expected {
+			t.Errorf("LoadBalancer(%q, %d, %q) = %+v, %v, expected %+v", tc.lbType, tc.sizeUnit, tc.sizeSlug, price, err, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package pricing
+
+import (
+	"fmt"
+	"strings"
+)
+
+// hoursPerMonth is the number of hours after which hourly billing reaches the
+// monthly price.
+const hoursPerMonth = 672
+
+// The API does not provide a price catalog for the following products, so the
+// list prices published at https://www.digitalocean.com/pricing are hardcoded
+// here. They are only estimates: they are not updated when the published
+// prices change, and do not reflect custom pricing. Database clusters are not
+// priced at all, as their price depends on the engine and on the number of
+// standby nodes.
+var (
+	// volumePricePerGiB is the price of a GiB of block storage.
+	volumePricePerGiB = monthlyListPrice(0.10)
+
+	// loadBalancerNodePrice is the price of a node of a regional load
+	// balancer.
+	loadBalancerNodePrice = monthlyListPrice(12)
+
+	// kubernetesHAControlPlanePrice is the price of the highly available
+	// control plane of a Kubernetes cluster.
+	kubernetesHAControlPlanePrice = monthlyListPrice(40)
+
+	// loadBalancerSizeUnits are the number of nodes of the deprecated load
+	// balancer size slugs.
+	loadBalancerSizeUnits = map[string]int{
+		"lb-small":  1,
+		"lb-medium": 3,
+		"lb-large":  6,
+	}
+)
+
+func monthlyListPrice(monthly float64) Price {
+	return Price{
+		Monthly: monthly,
+		Hourly:  monthly / hoursPerMonth,
+	}.Rounded()
+}
+
+// Volume returns the estimated price of a block storage volume of the given
+// size, based on the hardcoded list price.
+func (c *Catalog) Volume(sizeGiB int) Price {
+	return volumePricePerGiB.Times(sizeGiB).Rounded()
+}
+
+// LoadBalancer returns the estimated price of a regional load balancer, based
+// on the hardcoded list price, given either its number of nodes or, for load
+// balancers created before sizes were given in nodes, its size slug. Global
+// load balancers are not priced.
+func (c *Catalog) LoadBalancer(lbType string, sizeUnit int, sizeSlug string) (Price, error) {
+	if strings.EqualFold(lbType, "GLOBAL") {
+		return Price{}, fmt.Errorf("%w for global load balancers", ErrPriceNotFound)
+	}
+
+	if sizeUnit == 0 {
+		units, ok := loadBalancerSizeUnits[sizeSlug]
+		if !ok {
+			return Price{}, fmt.Errorf("%w for load balancer size %s", ErrPriceNotFound, sizeSlug)
+		}
+		sizeUnit = units
+	}
+
+	return loadBalancerNodePrice.Times(sizeUnit).Rounded(), nil
+}
+
+// KubernetesHAControlPlane returns the estimated price of the highly available
+// control plane of a Kubernetes cluster, based on the hardcoded list price.
+// Other control planes are free.
+func (c *Catalog) KubernetesHAControlPlane() Price {
+	return kubernetesHAControlPlanePrice
+}
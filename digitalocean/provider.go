@@ -5,19 +5,24 @@ import (
 
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/account"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/app"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/billing"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/byoip"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/cdn"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/certificate"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/costestimate"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/database"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/domain"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/droplet"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/dropletautoscale"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/firewall"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/functions"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/genai"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/image"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/kubernetes"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/loadbalancer"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/monitoring"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/oneclick"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/partnernetworkconnect"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/project"
 	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/region"
@@ -102,69 +107,97 @@ func Provider() *schema.Provider {
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"digitalocean_account":                           account.DataSourceDigitalOceanAccount(),
-			"digitalocean_app":                               app.DataSourceDigitalOceanApp(),
-			"digitalocean_certificate":                       certificate.DataSourceDigitalOceanCertificate(),
-			"digitalocean_container_registry":                registry.DataSourceDigitalOceanContainerRegistry(),
-			"digitalocean_database_cluster":                  database.DataSourceDigitalOceanDatabaseCluster(),
-			"digitalocean_database_connection_pool":          database.DataSourceDigitalOceanDatabaseConnectionPool(),
-			"digitalocean_database_ca":                       database.DataSourceDigitalOceanDatabaseCA(),
-			"digitalocean_database_replica":                  database.DataSourceDigitalOceanDatabaseReplica(),
-			"digitalocean_database_user":                     database.DataSourceDigitalOceanDatabaseUser(),
-			"digitalocean_domain":                            domain.DataSourceDigitalOceanDomain(),
-			"digitalocean_domains":                           domain.DataSourceDigitalOceanDomains(),
-			"digitalocean_droplet":                           droplet.DataSourceDigitalOceanDroplet(),
-			"digitalocean_droplet_autoscale":                 dropletautoscale.DataSourceDigitalOceanDropletAutoscale(),
-			"digitalocean_droplets":                          droplet.DataSourceDigitalOceanDroplets(),
-			"digitalocean_droplet_snapshot":                  snapshot.DataSourceDigitalOceanDropletSnapshot(),
-			"digitalocean_firewall":                          firewall.DataSourceDigitalOceanFirewall(),
-			"digitalocean_floating_ip":                       reservedip.DataSourceDigitalOceanFloatingIP(),
-			"digitalocean_image":                             image.DataSourceDigitalOceanImage(),
-			"digitalocean_images":                            image.DataSourceDigitalOceanImages(),
-			"digitalocean_kubernetes_cluster":                kubernetes.DataSourceDigitalOceanKubernetesCluster(),
-			"digitalocean_kubernetes_versions":               kubernetes.DataSourceDigitalOceanKubernetesVersions(),
-			"digitalocean_loadbalancer":                      loadbalancer.DataSourceDigitalOceanLoadbalancer(),
-			"digitalocean_project":                           project.DataSourceDigitalOceanProject(),
-			"digitalocean_projects":                          project.DataSourceDigitalOceanProjects(),
-			"digitalocean_record":                            domain.DataSourceDigitalOceanRecord(),
-			"digitalocean_records":                           domain.DataSourceDigitalOceanRecords(),
-			"digitalocean_region":                            region.DataSourceDigitalOceanRegion(),
-			"digitalocean_regions":                           region.DataSourceDigitalOceanRegions(),
-			"digitalocean_reserved_ip":                       reservedip.DataSourceDigitalOceanReservedIP(),
-			"digitalocean_reserved_ipv6":                     reservedipv6.DataSourceDigitalOceanReservedIPV6(),
-			"digitalocean_sizes":                             size.DataSourceDigitalOceanSizes(),
-			"digitalocean_spaces_bucket":                     spaces.DataSourceDigitalOceanSpacesBucket(),
-			"digitalocean_spaces_buckets":                    spaces.DataSourceDigitalOceanSpacesBuckets(),
-			"digitalocean_spaces_key":                        spaces.DataSourceDigitalOceanSpacesKey(),
-			"digitalocean_spaces_bucket_object":              spaces.DataSourceDigitalOceanSpacesBucketObject(),
-			"digitalocean_spaces_bucket_objects":             spaces.DataSourceDigitalOceanSpacesBucketObjects(),
-			"digitalocean_ssh_key":                           sshkey.DataSourceDigitalOceanSSHKey(),
-			"digitalocean_ssh_keys":                          sshkey.DataSourceDigitalOceanSSHKeys(),
-			"digitalocean_tag":                               tag.DataSourceDigitalOceanTag(),
-			"digitalocean_tags":                              tag.DataSourceDigitalOceanTags(),
-			"digitalocean_volume_snapshot":                   snapshot.DataSourceDigitalOceanVolumeSnapshot(),
-			"digitalocean_volume":                            volume.DataSourceDigitalOceanVolume(),
-			"digitalocean_vpc":                               vpc.DataSourceDigitalOceanVPC(),
-			"digitalocean_vpc_nat_gateway":                   vpcnatgateway.DataSourceDigitalOceanVPCNATGateway(),
-			"digitalocean_vpc_peering":                       vpcpeering.DataSourceDigitalOceanVPCPeering(),
-			"digitalocean_partner_attachment":                partnernetworkconnect.DataSourceDigitalOceanPartnerAttachment(),
-			"digitalocean_partner_attachment_service_key":    partnernetworkconnect.DataSourceDigitalOceanPartnerAttachmentServiceKey(),
-			"digitalocean_genai_agent":                       genai.DataSourceDigitalOceanAgent(),
-			"digitalocean_genai_agents":                      genai.DataSourceDigitalOceanAgents(),
-			"digitalocean_genai_agent_versions":              genai.DataSourceDigitalOceanAgentVersions(),
-			"digitalocean_genai_knowledge_base":              genai.DataSourceDigitalOceanKnowledgeBase(),
-			"digitalocean_genai_knowledge_bases":             genai.DataSourceDigitalOceanKnowledgeBases(),
-			"digitalocean_genai_knowledge_base_data_sources": genai.DataSourceDigitalOceanKnowledgeBaseDatasources(),
-			"digitalocean_genai_openai_api_key":              genai.DataSourceDigitalOceanOpenAIApiKey(),
-			"digitalocean_genai_openai_api_keys":             genai.DataSourceDigitalOceanOpenAIApiKeys(),
-			"digitalocean_genai_agents_by_openai_api_key":    genai.DataSourceDigitalOceanAgentsByOpenAIApiKey(),
+			"digitalocean_account":                                 account.DataSourceDigitalOceanAccount(),
+			"digitalocean_app":                                     app.DataSourceDigitalOceanApp(),
+			"digitalocean_balance":                                 billing.DataSourceDigitalOceanBalance(),
+			"digitalocean_billing_history":                         billing.DataSourceDigitalOceanBillingHistory(),
+			"digitalocean_byoip_prefix_resources":                  byoip.DataSourceDigitalOceanBYOIPPrefixResources(),
+			"digitalocean_certificate":                             certificate.DataSourceDigitalOceanCertificate(),
+			"digitalocean_container_registries":                    registry.DataSourceDigitalOceanContainerRegistries(),
+			"digitalocean_container_registry":                      registry.DataSourceDigitalOceanContainerRegistry(),
+			"digitalocean_container_registry_garbage_collections":  registry.DataSourceDigitalOceanContainerRegistryGarbageCollections(),
+			"digitalocean_container_registry_repositories":         registry.DataSourceDigitalOceanContainerRegistryRepositories(),
+			"digitalocean_container_registry_repository_manifests": registry.DataSourceDigitalOceanContainerRegistryRepositoryManifests(),
+			"digitalocean_container_registry_repository_tags":      registry.DataSourceDigitalOceanContainerRegistryRepositoryTags(),
+			"digitalocean_cost_estimate":                           costestimate.DataSourceDigitalOceanCostEstimate(),
+			"digitalocean_database_cluster":                        database.DataSourceDigitalOceanDatabaseCluster(),
+			"digitalocean_database_connection_pool":                database.DataSourceDigitalOceanDatabaseConnectionPool(),
+			"digitalocean_database_ca":                             database.DataSourceDigitalOceanDatabaseCA(),
+			"digitalocean_database_replica":                        database.DataSourceDigitalOceanDatabaseReplica(),
+			"digitalocean_database_user":                           database.DataSourceDigitalOceanDatabaseUser(),
+			"digitalocean_domain":                                  domain.DataSourceDigitalOceanDomain(),
+			"digitalocean_domains":                                 domain.DataSourceDigitalOceanDomains(),
+			"digitalocean_droplet":                                 droplet.DataSourceDigitalOceanDroplet(),
+			"digitalocean_droplet_autoscale":                       dropletautoscale.DataSourceDigitalOceanDropletAutoscale(),
+			"digitalocean_droplet_autoscale_history":               dropletautoscale.DataSourceDigitalOceanDropletAutoscaleHistory(),
+			"digitalocean_droplet_backup_policies":                 droplet.DataSourceDigitalOceanDropletBackupPolicies(),
+			"digitalocean_droplet_backups":                         droplet.DataSourceDigitalOceanDropletBackups(),
+			"digitalocean_droplet_kernels":                         droplet.DataSourceDigitalOceanDropletKernels(),
+			"digitalocean_droplet_neighbors":                       droplet.DataSourceDigitalOceanDropletNeighbors(),
+			"digitalocean_droplets":                                droplet.DataSourceDigitalOceanDroplets(),
+			"digitalocean_droplet_snapshot":                        snapshot.DataSourceDigitalOceanDropletSnapshot(),
+			"digitalocean_droplet_supported_backup_policies":       droplet.DataSourceDigitalOceanDropletSupportedBackupPolicies(),
+			"digitalocean_firewall":                                firewall.DataSourceDigitalOceanFirewall(),
+			"digitalocean_floating_ip":                             reservedip.DataSourceDigitalOceanFloatingIP(),
+			"digitalocean_functions_namespace":                     functions.DataSourceDigitalOceanFunctionsNamespace(),
+			"digitalocean_functions_trigger":                       functions.DataSourceDigitalOceanFunctionsTrigger(),
+			"digitalocean_image":                                   image.DataSourceDigitalOceanImage(),
+			"digitalocean_images":                                  image.DataSourceDigitalOceanImages(),
+			"digitalocean_invoice":                                 billing.DataSourceDigitalOceanInvoice(),
+			"digitalocean_invoices":                                billing.DataSourceDigitalOceanInvoices(),
+			"digitalocean_kubernetes_cluster":                      kubernetes.DataSourceDigitalOceanKubernetesCluster(),
+			"digitalocean_kubernetes_kubeconfig":                   kubernetes.DataSourceDigitalOceanKubernetesKubeconfig(),
+			"digitalocean_kubernetes_node_pool_template":           kubernetes.DataSourceDigitalOceanKubernetesNodePoolTemplate(),
+			"digitalocean_kubernetes_versions":                     kubernetes.DataSourceDigitalOceanKubernetesVersions(),
+			"digitalocean_loadbalancer":                            loadbalancer.DataSourceDigitalOceanLoadbalancer(),
+			"digitalocean_one_click_apps":                          oneclick.DataSourceDigitalOceanOneClickApps(),
+			"digitalocean_project":                                 project.DataSourceDigitalOceanProject(),
+			"digitalocean_projects":                                project.DataSourceDigitalOceanProjects(),
+			"digitalocean_record":                                  domain.DataSourceDigitalOceanRecord(),
+			"digitalocean_records":                                 domain.DataSourceDigitalOceanRecords(),
+			"digitalocean_region":                                  region.DataSourceDigitalOceanRegion(),
+			"digitalocean_regions":                                 region.DataSourceDigitalOceanRegions(),
+			"digitalocean_reserved_ip":                             reservedip.DataSourceDigitalOceanReservedIP(),
+			"digitalocean_reserved_ipv6":                           reservedipv6.DataSourceDigitalOceanReservedIPV6(),
+			"digitalocean_sizes":                                   size.DataSourceDigitalOceanSizes(),
+			"digitalocean_spaces_bucket":                           spaces.DataSourceDigitalOceanSpacesBucket(),
+			"digitalocean_spaces_buckets":                          spaces.DataSourceDigitalOceanSpacesBuckets(),
+			"digitalocean_spaces_key":                              spaces.DataSourceDigitalOceanSpacesKey(),
+			"digitalocean_spaces_bucket_object":                    spaces.DataSourceDigitalOceanSpacesBucketObject(),
+			"digitalocean_spaces_bucket_objects":                   spaces.DataSourceDigitalOceanSpacesBucketObjects(),
+			"digitalocean_ssh_key":                                 sshkey.DataSourceDigitalOceanSSHKey(),
+			"digitalocean_ssh_keys":                                sshkey.DataSourceDigitalOceanSSHKeys(),
+			"digitalocean_tag":                                     tag.DataSourceDigitalOceanTag(),
+			"digitalocean_tags":                                    tag.DataSourceDigitalOceanTags(),
+			"digitalocean_volume_snapshot":                         snapshot.DataSourceDigitalOceanVolumeSnapshot(),
+			"digitalocean_volume":                                  volume.DataSourceDigitalOceanVolume(),
+			"digitalocean_vpc":                                     vpc.DataSourceDigitalOceanVPC(),
+			"digitalocean_vpc_nat_gateway":                         vpcnatgateway.DataSourceDigitalOceanVPCNATGateway(),
+			"digitalocean_vpc_peering":                             vpcpeering.DataSourceDigitalOceanVPCPeering(),
+			"digitalocean_partner_attachment":                      partnernetworkconnect.DataSourceDigitalOceanPartnerAttachment(),
+			"digitalocean_partner_attachment_service_key":          partnernetworkconnect.DataSourceDigitalOceanPartnerAttachmentServiceKey(),
+			"digitalocean_genai_agent":                             genai.DataSourceDigitalOceanAgent(),
+			"digitalocean_genai_agents":                            genai.DataSourceDigitalOceanAgents(),
+			"digitalocean_genai_agent_versions":                    genai.DataSourceDigitalOceanAgentVersions(),
+			"digitalocean_genai_knowledge_base":                    genai.DataSourceDigitalOceanKnowledgeBase(),
+			"digitalocean_genai_knowledge_bases":                   genai.DataSourceDigitalOceanKnowledgeBases(),
+			"digitalocean_genai_knowledge_base_data_sources":       genai.DataSourceDigitalOceanKnowledgeBaseDatasources(),
+			"digitalocean_genai_openai_api_key":                    genai.DataSourceDigitalOceanOpenAIApiKey(),
+			"digitalocean_genai_openai_api_keys":                   genai.DataSourceDigitalOceanOpenAIApiKeys(),
+			"digitalocean_genai_agents_by_openai_api_key":          genai.DataSourceDigitalOceanAgentsByOpenAIApiKey(),
+			"digitalocean_genai_anthropic_api_key":                 genai.DataSourceDigitalOceanAnthropicApiKey(),
+			"digitalocean_genai_anthropic_api_keys":                genai.DataSourceDigitalOceanAnthropicApiKeys(),
+			"digitalocean_genai_agents_by_anthropic_api_key":       genai.DataSourceDigitalOceanAgentsByAnthropicApiKey(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"digitalocean_app":                                   app.ResourceDigitalOceanApp(),
+			"digitalocean_byoip_prefix":                          byoip.ResourceDigitalOceanBYOIPPrefix(),
 			"digitalocean_certificate":                           certificate.ResourceDigitalOceanCertificate(),
+			"digitalocean_container_registries":                  registry.ResourceDigitalOceanContainerRegistries(),
 			"digitalocean_container_registry":                    registry.ResourceDigitalOceanContainerRegistry(),
 			"digitalocean_container_registry_docker_credentials": registry.ResourceDigitalOceanContainerRegistryDockerCredentials(),
+			"digitalocean_container_registry_retention":          registry.ResourceDigitalOceanContainerRegistryRetention(),
 			"digitalocean_cdn":                                   cdn.ResourceDigitalOceanCDN(),
 			"digitalocean_database_cluster":                      database.ResourceDigitalOceanDatabaseCluster(),
 			"digitalocean_database_connection_pool":              database.ResourceDigitalOceanDatabaseConnectionPool(),
@@ -181,13 +214,21 @@ func Provider() *schema.Provider {
 			"digitalocean_database_kafka_topic":                  database.ResourceDigitalOceanDatabaseKafkaTopic(),
 			"digitalocean_domain":                                domain.ResourceDigitalOceanDomain(),
 			"digitalocean_droplet":                               droplet.ResourceDigitalOceanDroplet(),
+			"digitalocean_droplet_action":                        droplet.ResourceDigitalOceanDropletAction(),
 			"digitalocean_droplet_autoscale":                     dropletautoscale.ResourceDigitalOceanDropletAutoscale(),
+			"digitalocean_droplet_group":                         droplet.ResourceDigitalOceanDropletGroup(),
 			"digitalocean_droplet_snapshot":                      snapshot.ResourceDigitalOceanDropletSnapshot(),
 			"digitalocean_firewall":                              firewall.ResourceDigitalOceanFirewall(),
 			"digitalocean_floating_ip":                           reservedip.ResourceDigitalOceanFloatingIP(),
 			"digitalocean_floating_ip_assignment":                reservedip.ResourceDigitalOceanFloatingIPAssignment(),
+			"digitalocean_function":                              functions.ResourceDigitalOceanFunction(),
+			"digitalocean_functions_namespace":                   functions.ResourceDigitalOceanFunctionsNamespace(),
+			"digitalocean_functions_trigger":                     functions.ResourceDigitalOceanFunctionsTrigger(),
 			"digitalocean_kubernetes_cluster":                    kubernetes.ResourceDigitalOceanKubernetesCluster(),
 			"digitalocean_kubernetes_node_pool":                  kubernetes.ResourceDigitalOceanKubernetesNodePool(),
+			"digitalocean_kubernetes_node_pool_recycle":          kubernetes.ResourceDigitalOceanKubernetesNodePoolRecycle(),
+			"digitalocean_kubernetes_one_click":                  oneclick.ResourceDigitalOceanKubernetesOneClick(),
+			"digitalocean_kubernetes_registry_attachment":        kubernetes.ResourceDigitalOceanKubernetesRegistryAttachment(),
 			"digitalocean_loadbalancer":                          loadbalancer.ResourceDigitalOceanLoadbalancer(),
 			"digitalocean_monitor_alert":                         monitoring.ResourceDigitalOceanMonitorAlert(),
 			"digitalocean_project":                               project.ResourceDigitalOceanProject(),
@@ -216,11 +257,13 @@ func Provider() *schema.Provider {
 			"digitalocean_custom_image":                          image.ResourceDigitalOceanCustomImage(),
 			"digitalocean_partner_attachment":                    partnernetworkconnect.ResourceDigitalOceanPartnerAttachment(),
 			"digitalocean_genai_agent":                           genai.ResourceDigitalOceanAgent(),
+			"digitalocean_genai_agent_api_key":                   genai.ResourceDigitalOceanAgentAPIKey(),
 			"digitalocean_genai_function":                        genai.ResourceDigitalOceanGenAIFunctionRoute(),
 			"digitalocean_genai_knowledge_base":                  genai.ResourceDigitalOceanKnowledgeBase(),
 			"digitalocean_genai_knowledge_base_data_source":      genai.ResourceDigitalOceanKnowledgeBaseDataSource(),
 			"digitalocean_genai_agent_knowledge_base_attachment": genai.ResourceDigitalOceanAgentKnowledgeBaseAttachment(),
 			"digitalocean_genai_openai_api_key":                  genai.ResourceDigitalOceanOpenAIApiKey(),
+			"digitalocean_genai_anthropic_api_key":               genai.ResourceDigitalOceanAnthropicApiKey(),
 		},
 	}
 
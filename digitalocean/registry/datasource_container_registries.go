@@ -0,0 +1,85 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanContainerRegistries() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        registrySchema(),
+		ResultAttributeName: "registries",
+		GetRecords:          getDigitalOceanContainerRegistries,
+		FlattenRecord:       flattenDigitalOceanContainerRegistry,
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func registrySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the container registry",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Description: "the region the container registry is located in",
+		},
+		"endpoint": {
+			Type:        schema.TypeString,
+			Description: "the URL images in the container registry are pushed to and pulled from",
+		},
+		"server_url": {
+			Type:        schema.TypeString,
+			Description: "the hostname of the container registry server",
+		},
+		"storage_usage_bytes": {
+			Type:        schema.TypeInt,
+			Description: "the storage used by the container registry in bytes",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the date the container registry was created",
+		},
+	}
+}
+
+func getDigitalOceanContainerRegistries(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	registries, _, err := client.Registries.List(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving container registries: %s", err)
+	}
+
+	var registryList []interface{}
+	for _, reg := range registries {
+		registryList = append(registryList, reg)
+	}
+
+	return registryList, nil
+}
+
+func flattenDigitalOceanContainerRegistry(rawRegistry, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	reg, ok := rawRegistry.(*godo.Registry)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to *godo.Registry")
+	}
+
+	flattenedRegistry := map[string]interface{}{
+		"name":                reg.Name,
+		"region":              reg.Region,
+		"endpoint":            fmt.Sprintf("%s/%s", RegistryHostname, reg.Name),
+		"server_url":          RegistryHostname,
+		"storage_usage_bytes": int(reg.StorageUsageBytes),
+		"created_at":          reg.CreatedAt.UTC().String(),
+	}
+
+	return flattenedRegistry, nil
+}
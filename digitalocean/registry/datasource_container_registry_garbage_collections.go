@@ -0,0 +1,134 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanContainerRegistryGarbageCollections() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        garbageCollectionSchema(),
+		ResultAttributeName: "garbage_collections",
+		GetRecords:          getDigitalOceanContainerRegistryGarbageCollections,
+		FlattenRecord:       flattenDigitalOceanContainerRegistryGarbageCollection,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"registry_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func garbageCollectionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"uuid": {
+			Type:        schema.TypeString,
+			Description: "UUID of the garbage collection",
+		},
+		"registry_name": {
+			Type:        schema.TypeString,
+			Description: "name of the container registry",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "status of the garbage collection",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Description: "type of the garbage collection",
+		},
+		"blobs_deleted": {
+			Type:        schema.TypeInt,
+			Description: "number of blobs deleted by the garbage collection",
+		},
+		"freed_bytes": {
+			Type:        schema.TypeInt,
+			Description: "number of bytes freed by the garbage collection",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Description: "the date the garbage collection was started",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "the date the garbage collection was last updated",
+		},
+	}
+}
+
+func getDigitalOceanContainerRegistryGarbageCollections(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	gcs, err := listContainerRegistryGarbageCollections(client, extra["registry_name"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	var gcList []interface{}
+	for _, gc := range gcs {
+		gcList = append(gcList, gc)
+	}
+
+	return gcList, nil
+}
+
+func listContainerRegistryGarbageCollections(client *godo.Client, registryName string) ([]*godo.GarbageCollection, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var gcList []*godo.GarbageCollection
+
+	for {
+		gcs, resp, err := client.Registry.ListGarbageCollections(context.Background(), registryName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving garbage collections of container registry (%s): %s", registryName, err)
+		}
+
+		gcList = append(gcList, gcs...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving garbage collections of container registry (%s): %s", registryName, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return gcList, nil
+}
+
+func flattenDigitalOceanContainerRegistryGarbageCollection(rawGC, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	gc, ok := rawGC.(*godo.GarbageCollection)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to *godo.GarbageCollection")
+	}
+
+	flattenedGC := map[string]interface{}{
+		"uuid":          gc.UUID,
+		"registry_name": gc.RegistryName,
+		"status":        gc.Status,
+		"type":          string(gc.Type),
+		"blobs_deleted": int(gc.BlobsDeleted),
+		"freed_bytes":   int(gc.FreedBytes),
+		"created_at":    gc.CreatedAt.UTC().String(),
+		"updated_at":    gc.UpdatedAt.UTC().String(),
+	}
+
+	return flattenedGC, nil
+}
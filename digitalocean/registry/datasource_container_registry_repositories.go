@@ -0,0 +1,144 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanContainerRegistryRepositories() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        repositorySchema(),
+		ResultAttributeName: "repositories",
+		GetRecords:          getDigitalOceanContainerRegistryRepositories,
+		FlattenRecord:       flattenDigitalOceanContainerRegistryRepository,
+		ExtraQuerySchema: map[string]*schema.Schema{
+			"registry_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func repositorySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: "name of the repository",
+		},
+		"registry_name": {
+			Type:        schema.TypeString,
+			Description: "name of the container registry",
+		},
+		"tag_count": {
+			Type:        schema.TypeInt,
+			Description: "number of tags in the repository",
+		},
+		"manifest_count": {
+			Type:        schema.TypeInt,
+			Description: "number of manifests in the repository",
+		},
+		"latest_manifest_digest": {
+			Type:        schema.TypeString,
+			Description: "digest of the most recently updated manifest",
+		},
+		"latest_manifest_tags": {
+			Type:        schema.TypeList,
+			Description: "tags of the most recently updated manifest",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "the date the most recently updated manifest was updated",
+		},
+	}
+}
+
+func getDigitalOceanContainerRegistryRepositories(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	repositories, err := listContainerRegistryRepositories(client, extra["registry_name"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	var repositoryList []interface{}
+	for _, repository := range repositories {
+		repositoryList = append(repositoryList, repository)
+	}
+
+	return repositoryList, nil
+}
+
+func listContainerRegistryRepositories(client *godo.Client, registryName string) ([]*godo.RepositoryV2, error) {
+	opts := &godo.TokenListOptions{
+		PerPage: 200,
+	}
+
+	var repositoryList []*godo.RepositoryV2
+
+	for {
+		repositories, resp, err := client.Registry.ListRepositoriesV2(context.Background(), registryName, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving repositories of container registry (%s): %s", registryName, err)
+		}
+
+		repositoryList = append(repositoryList, repositories...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		token, err := resp.Links.NextPageToken()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving repositories of container registry (%s): %s", registryName, err)
+		}
+
+		opts.Token = token
+	}
+
+	return repositoryList, nil
+}
+
+func flattenDigitalOceanContainerRegistryRepository(rawRepository, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	repository, ok := rawRepository.(*godo.RepositoryV2)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to *godo.RepositoryV2")
+	}
+
+	flattenedRepository := map[string]interface{}{
+		"name":                   repository.Name,
+		"registry_name":          repository.RegistryName,
+		"tag_count":              int(repository.TagCount),
+		"manifest_count":         int(repository.ManifestCount),
+		"latest_manifest_digest": "",
+		"latest_manifest_tags":   []interface{}{},
+		"updated_at":             "",
+	}
+
+	if manifest := repository.LatestManifest; manifest != nil {
+		flattenedRepository["latest_manifest_digest"] = manifest.Digest
+		flattenedRepository["latest_manifest_tags"] = flattenStringList(manifest.Tags)
+		flattenedRepository["updated_at"] = manifest.UpdatedAt.UTC().String()
+	}
+
+	return flattenedRepository, nil
+}
+
+func flattenStringList(list []string) []interface{} {
+	flattened := make([]interface{}, 0, len(list))
+	for _, v := range list {
+		flattened = append(flattened, v)
+	}
+
+	return flattened
+}
@@ -0,0 +1,116 @@
+package registry_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const registryRepositoryEnvVar = "DO_TEST_REGISTRY_REPOSITORY"
+
+func TestAccDataSourceDigitalOceanContainerRegistryRepositories_Empty(t *testing.T) {
+	regName := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_container_registry" "foo" {
+  name                   = "%s"
+  subscription_tier_slug = "basic"
+}
+`, regName)
+
+	dataSourceConfig := `
+data "digitalocean_container_registry_repositories" "foobar" {
+  registry_name = digitalocean_container_registry.foo.name
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_container_registry_repositories.foobar", "repositories.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// The registry is not populated by the tests, so the repository must be pushed
+// beforehand and set as `registry/repository`.
+func TestAccDataSourceDigitalOceanContainerRegistryRepositoryTagsAndManifests(t *testing.T) {
+	registryName, repository, ok := strings.Cut(os.Getenv(registryRepositoryEnvVar), "/")
+	if !ok {
+		t.Skipf("Test requires an image pushed to a container registry. Set %s to `registry/repository`", registryRepositoryEnvVar)
+	}
+
+	config := fmt.Sprintf(`
+data "digitalocean_container_registry_repositories" "foo" {
+  registry_name = "%[1]s"
+
+  filter {
+    key    = "name"
+    values = ["%[2]s"]
+  }
+}
+
+data "digitalocean_container_registry_repository_tags" "foo" {
+  registry_name = "%[1]s"
+  repository    = "%[2]s"
+
+  sort {
+    key       = "updated_at"
+    direction = "desc"
+  }
+}
+
+data "digitalocean_container_registry_repository_manifests" "foo" {
+  registry_name = "%[1]s"
+  repository    = "%[2]s"
+
+  filter {
+    key    = "digest"
+    values = [data.digitalocean_container_registry_repository_tags.foo.tags[0].manifest_digest]
+  }
+}
+`, registryName, repository)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_container_registry_repositories.foo", "repositories.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_container_registry_repositories.foo", "repositories.0.name", repository),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_container_registry_repositories.foo", "repositories.0.registry_name", registryName),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_container_registry_repositories.foo", "repositories.0.latest_manifest_digest"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_container_registry_repository_tags.foo", "tags.0.tag"),
+					resource.TestCheckResourceAttrSet(
+						"data.digitalocean_container_registry_repository_tags.foo", "tags.0.updated_at"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_container_registry_repository_manifests.foo", "manifests.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.digitalocean_container_registry_repository_manifests.foo", "manifests.0.digest",
+						"data.digitalocean_container_registry_repository_tags.foo", "tags.0.manifest_digest"),
+				),
+			},
+		},
+	})
+}
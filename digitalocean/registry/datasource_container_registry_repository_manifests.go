@@ -0,0 +1,113 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceDigitalOceanContainerRegistryRepositoryManifests() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        repositoryManifestSchema(),
+		ResultAttributeName: "manifests",
+		GetRecords:          getDigitalOceanContainerRegistryRepositoryManifests,
+		FlattenRecord:       flattenDigitalOceanContainerRegistryRepositoryManifest,
+		ExtraQuerySchema:    repositoryQuerySchema(),
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+func repositoryManifestSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"digest": {
+			Type:        schema.TypeString,
+			Description: "digest of the manifest",
+		},
+		"tags": {
+			Type:        schema.TypeList,
+			Description: "tags pointing to the manifest",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"compressed_size_bytes": {
+			Type:        schema.TypeInt,
+			Description: "compressed size of the image in bytes",
+		},
+		"size_bytes": {
+			Type:        schema.TypeInt,
+			Description: "uncompressed size of the image in bytes",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "the date the manifest was last updated",
+		},
+	}
+}
+
+func getDigitalOceanContainerRegistryRepositoryManifests(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	manifests, err := listContainerRegistryRepositoryManifests(client, extra["registry_name"].(string), extra["repository"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	var manifestList []interface{}
+	for _, manifest := range manifests {
+		manifestList = append(manifestList, manifest)
+	}
+
+	return manifestList, nil
+}
+
+func listContainerRegistryRepositoryManifests(client *godo.Client, registryName string, repository string) ([]*godo.RepositoryManifest, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var manifestList []*godo.RepositoryManifest
+
+	for {
+		manifests, resp, err := client.Registry.ListRepositoryManifests(context.Background(), registryName, repository, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving manifests of repository (%s/%s): %s", registryName, repository, err)
+		}
+
+		manifestList = append(manifestList, manifests...)
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving manifests of repository (%s/%s): %s", registryName, repository, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return manifestList, nil
+}
+
+func flattenDigitalOceanContainerRegistryRepositoryManifest(rawManifest, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	manifest, ok := rawManifest.(*godo.RepositoryManifest)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to *godo.RepositoryManifest")
+	}
+
+	flattenedManifest := map[string]interface{}{
+		"digest":                manifest.Digest,
+		"tags":                  flattenStringList(manifest.Tags),
+		"compressed_size_bytes": int(manifest.CompressedSizeBytes),
+		"size_bytes":            int(manifest.SizeBytes),
+		"updated_at":            manifest.UpdatedAt.UTC().String(),
+	}
+
+	return flattenedManifest, nil
+}
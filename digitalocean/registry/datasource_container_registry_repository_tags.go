@@ -0,0 +1,120 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/digitalocean/terraform-provider-digitalocean/internal/datalist"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceDigitalOceanContainerRegistryRepositoryTags() *schema.Resource {
+	dataListConfig := &datalist.ResourceConfig{
+		RecordSchema:        repositoryTagSchema(),
+		ResultAttributeName: "tags",
+		GetRecords:          getDigitalOceanContainerRegistryRepositoryTags,
+		FlattenRecord:       flattenDigitalOceanContainerRegistryRepositoryTag,
+		ExtraQuerySchema:    repositoryQuerySchema(),
+	}
+
+	return datalist.NewResource(dataListConfig)
+}
+
+// repositoryQuerySchema identifies the repository whose tags or manifests are listed.
+func repositoryQuerySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"registry_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"repository": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.NoZeroValues,
+		},
+	}
+}
+
+func repositoryTagSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"tag": {
+			Type:        schema.TypeString,
+			Description: "name of the tag",
+		},
+		"manifest_digest": {
+			Type:        schema.TypeString,
+			Description: "digest of the manifest the tag points to",
+		},
+		"compressed_size_bytes": {
+			Type:        schema.TypeInt,
+			Description: "compressed size of the tagged image in bytes",
+		},
+		"size_bytes": {
+			Type:        schema.TypeInt,
+			Description: "uncompressed size of the tagged image in bytes",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Description: "the date the tag was last updated",
+		},
+	}
+}
+
+func getDigitalOceanContainerRegistryRepositoryTags(meta interface{}, extra map[string]interface{}) ([]interface{}, error) {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	registryName := extra["registry_name"].(string)
+	repository := extra["repository"].(string)
+
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: 200,
+	}
+
+	var tagList []interface{}
+
+	for {
+		tags, resp, err := client.Registry.ListRepositoryTags(context.Background(), registryName, repository, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving tags of repository (%s/%s): %s", registryName, repository, err)
+		}
+
+		for _, tag := range tags {
+			tagList = append(tagList, tag)
+		}
+
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving tags of repository (%s/%s): %s", registryName, repository, err)
+		}
+
+		opts.Page = page + 1
+	}
+
+	return tagList, nil
+}
+
+func flattenDigitalOceanContainerRegistryRepositoryTag(rawTag, meta interface{}, extra map[string]interface{}) (map[string]interface{}, error) {
+	tag, ok := rawTag.(*godo.RepositoryTag)
+	if !ok {
+		return nil, fmt.Errorf("Unable to convert to *godo.RepositoryTag")
+	}
+
+	flattenedTag := map[string]interface{}{
+		"tag":                   tag.Tag,
+		"manifest_digest":       tag.ManifestDigest,
+		"compressed_size_bytes": int(tag.CompressedSizeBytes),
+		"size_bytes":            int(tag.SizeBytes),
+		"updated_at":            tag.UpdatedAt.UTC().String(),
+	}
+
+	return flattenedTag, nil
+}
@@ -0,0 +1,163 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceDigitalOceanContainerRegistries manages an additional container
+// registry for accounts with more than one registry. All registries share the
+// subscription of the account.
+func ResourceDigitalOceanContainerRegistries() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanContainerRegistriesCreate,
+		ReadContext:   resourceDigitalOceanContainerRegistriesRead,
+		DeleteContext: resourceDigitalOceanContainerRegistriesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_usage_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: validateContainerRegistryName,
+	}
+}
+
+func resourceDigitalOceanContainerRegistriesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	opts := &godo.RegistryCreateRequest{
+		Name: d.Get("name").(string),
+	}
+
+	if region, ok := d.GetOk("region"); ok {
+		opts.Region = region.(string)
+	}
+
+	log.Printf("[DEBUG] Container Registry create configuration: %#v", opts)
+	reg, _, err := client.Registries.Create(context.Background(), opts)
+	if err != nil {
+		return diag.Errorf("Error creating container registry: %s", err)
+	}
+
+	d.SetId(reg.Name)
+	log.Printf("[INFO] Container Registry: %s", reg.Name)
+
+	return resourceDigitalOceanContainerRegistriesRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanContainerRegistriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	reg, resp, err := client.Registries.Get(context.Background(), d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Container registry (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving container registry: %s", err)
+	}
+
+	d.Set("name", reg.Name)
+	d.Set("region", reg.Region)
+	d.Set("endpoint", fmt.Sprintf("%s/%s", RegistryHostname, reg.Name))
+	d.Set("server_url", RegistryHostname)
+	d.Set("created_at", reg.CreatedAt.UTC().String())
+	d.Set("storage_usage_bytes", reg.StorageUsageBytes)
+
+	return nil
+}
+
+func resourceDigitalOceanContainerRegistriesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	log.Printf("[INFO] Deleting container registry: %s", d.Id())
+	resp, err := client.Registries.Delete(context.Background(), d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error deleting container registry: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// validateContainerRegistryName checks that the name of a new registry is
+// available so that a name already in use fails at plan time rather than
+// when the registry is created.
+func validateContainerRegistryName(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("name") || !diff.NewValueKnown("name") {
+		return nil
+	}
+
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	name := diff.Get("name").(string)
+	_, err := client.Registries.ValidateName(ctx, &godo.RegistryValidateNameRequest{Name: name})
+	if err != nil {
+		return fmt.Errorf("container registry name %q is not available: %s", name, err)
+	}
+
+	return nil
+}
+
+// getContainerRegistry retrieves a registry by name. The primary registry of
+// the account is retrieved using the original single registry API so that
+// accounts without access to multiple registries keep working. The returned
+// boolean reports whether the registry is the primary one.
+func getContainerRegistry(client *godo.Client, name string) (*godo.Registry, bool, *godo.Response, error) {
+	reg, resp, err := client.Registry.Get(context.Background())
+	if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return nil, false, resp, err
+	}
+	if err == nil && reg.Name == name {
+		return reg, true, resp, nil
+	}
+
+	reg, resp, err = client.Registries.Get(context.Background(), name)
+	return reg, false, resp, err
+}
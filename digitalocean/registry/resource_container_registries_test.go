@@ -0,0 +1,143 @@
+package registry_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDigitalOceanContainerRegistries_Basic(t *testing.T) {
+	primaryName := acceptance.RandomTestName()
+	name := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_container_registry" "primary" {
+  name                   = "%s"
+  subscription_tier_slug = "professional"
+}
+
+resource "digitalocean_container_registries" "foobar" {
+  name   = "%s"
+  region = "sfo3"
+
+  depends_on = [digitalocean_container_registry.primary]
+}
+
+resource "digitalocean_container_registry_docker_credentials" "foobar" {
+  registry_name = digitalocean_container_registries.foobar.name
+  write         = true
+}
+`, primaryName, name)
+
+	dataSourceConfig := `
+data "digitalocean_container_registries" "foobar" {
+  filter {
+    key    = "name"
+    values = [digitalocean_container_registries.foobar.name]
+  }
+}
+`
+
+	takenNameConfig := fmt.Sprintf(`
+resource "digitalocean_container_registries" "taken" {
+  name = "%s"
+}
+`, primaryName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckDigitalOceanContainerRegistriesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDigitalOceanContainerRegistriesExists("digitalocean_container_registries.foobar"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registries.foobar", "name", name),
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registries.foobar", "region", "sfo3"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registries.foobar", "endpoint", "registry.digitalocean.com/"+name),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_container_registries.foobar", "created_at"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registry_docker_credentials.foobar", "registry_name", name),
+					resource.TestCheckResourceAttrSet(
+						"digitalocean_container_registry_docker_credentials.foobar", "docker_credentials"),
+				),
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_container_registries.foobar", "registries.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_container_registries.foobar", "registries.0.name", name),
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_container_registries.foobar", "registries.0.region", "sfo3"),
+				),
+			},
+			{
+				Config:      resourceConfig + takenNameConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not available"),
+			},
+			{
+				ResourceName:      "digitalocean_container_registries.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDigitalOceanContainerRegistriesDestroy(s *terraform.State) error {
+	client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "digitalocean_container_registries" {
+			continue
+		}
+
+		_, _, err := client.Registries.Get(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Container Registry still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDigitalOceanContainerRegistriesExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		client := acceptance.TestAccProvider.Meta().(*config.CombinedConfig).GodoClient()
+
+		foundReg, _, err := client.Registries.Get(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if foundReg.Name != rs.Primary.ID {
+			return fmt.Errorf("Registry not found")
+		}
+
+		return nil
+	}
+}
@@ -71,7 +71,12 @@ func resourceDigitalOceanContainerRegistryDockerCredentialsCreate(ctx context.Co
 func resourceDigitalOceanContainerRegistryDockerCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
-	reg, response, err := client.Registry.Get(context.Background())
+	registryName := d.Get("registry_name").(string)
+	if registryName == "" {
+		registryName = d.Id()
+	}
+
+	reg, _, response, err := getContainerRegistry(client, registryName)
 
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
@@ -85,7 +90,7 @@ func resourceDigitalOceanContainerRegistryDockerCredentialsRead(ctx context.Cont
 	d.Set("registry_name", reg.Name)
 	d.Set("write", write)
 
-	err = updateExpiredDockerCredentials(d, write, client)
+	err = updateExpiredDockerCredentials(d, reg.Name, write, client)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -101,7 +106,7 @@ func resourceDigitalOceanContainerRegistryDockerCredentialsUpdate(ctx context.Co
 		currentTime := time.Now().UTC()
 		expirationTime := currentTime.Add(time.Second * time.Duration(expirySeconds))
 		d.Set("credential_expiration_time", expirationTime.Format(time.RFC3339))
-		dockerConfigJSON, err := generateDockerCredentials(write, expirySeconds, client)
+		dockerConfigJSON, err := generateDockerCredentials(d.Get("registry_name").(string), write, expirySeconds, client)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -112,7 +117,7 @@ func resourceDigitalOceanContainerRegistryDockerCredentialsUpdate(ctx context.Co
 			write := d.Get("write").(bool)
 			expirySeconds := d.Get("expiry_seconds").(int)
 			client := meta.(*config.CombinedConfig).GodoClient()
-			dockerConfigJSON, err := generateDockerCredentials(write, expirySeconds, client)
+			dockerConfigJSON, err := generateDockerCredentials(d.Get("registry_name").(string), write, expirySeconds, client)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -190,8 +195,21 @@ func RevokeOAuthToken(token string, endpoint string) error {
 	return err
 }
 
-func generateDockerCredentials(readWrite bool, expirySeconds int, client *godo.Client) (string, error) {
-	dockerCreds, response, err := client.Registry.DockerCredentials(context.Background(), &godo.RegistryDockerCredentialsRequest{ReadWrite: readWrite, ExpirySeconds: &expirySeconds})
+func generateDockerCredentials(registryName string, readWrite bool, expirySeconds int, client *godo.Client) (string, error) {
+	_, primary, _, err := getContainerRegistry(client, registryName)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving registry: %s", err)
+	}
+
+	request := &godo.RegistryDockerCredentialsRequest{ReadWrite: readWrite, ExpirySeconds: &expirySeconds}
+
+	var dockerCreds *godo.DockerCredentials
+	var response *godo.Response
+	if primary {
+		dockerCreds, response, err = client.Registry.DockerCredentials(context.Background(), request)
+	} else {
+		dockerCreds, response, err = client.Registries.DockerCredentials(context.Background(), registryName, request)
+	}
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			return "", fmt.Errorf("docker credentials not found: %s", err)
@@ -202,7 +220,7 @@ func generateDockerCredentials(readWrite bool, expirySeconds int, client *godo.C
 	return dockerConfigJSON, nil
 }
 
-func updateExpiredDockerCredentials(d *schema.ResourceData, readWrite bool, client *godo.Client) error {
+func updateExpiredDockerCredentials(d *schema.ResourceData, registryName string, readWrite bool, client *godo.Client) error {
 	expirySeconds := d.Get("expiry_seconds").(int)
 	expirationTime := d.Get("credential_expiration_time").(string)
 	d.Set("expiry_seconds", expirySeconds)
@@ -215,7 +233,7 @@ func updateExpiredDockerCredentials(d *schema.ResourceData, readWrite bool, clie
 		}
 
 		if expirationTime.Before(currentTime) {
-			dockerConfigJSON, err := generateDockerCredentials(readWrite, expirySeconds, client)
+			dockerConfigJSON, err := generateDockerCredentials(registryName, readWrite, expirySeconds, client)
 			if err != nil {
 				return err
 			}
@@ -227,7 +245,7 @@ func updateExpiredDockerCredentials(d *schema.ResourceData, readWrite bool, clie
 	} else {
 		expirationTime := currentTime.Add(time.Second * time.Duration(expirySeconds))
 		d.Set("credential_expiration_time", expirationTime.Format(time.RFC3339))
-		dockerConfigJSON, err := generateDockerCredentials(readWrite, expirySeconds, client)
+		dockerConfigJSON, err := generateDockerCredentials(registryName, readWrite, expirySeconds, client)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,455 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/config"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	garbageCollectionStatusSucceeded = "succeeded"
+	garbageCollectionStatusFailed    = "failed"
+	garbageCollectionStatusCancelled = "cancelled"
+	garbageCollectionStatusRunning   = "running"
+)
+
+// ContainerRegistryRetentionRule selects manifests to delete from the
+// repositories of a registry.
+type ContainerRegistryRetentionRule struct {
+	repository      *regexp.Regexp
+	tag             *regexp.Regexp
+	keepLast        int
+	olderThan       time.Duration
+	includeUntagged bool
+}
+
+// ContainerRegistryRetentionDeletion is a deletion from a repository planned
+// by the retention rules. When Digest is set the whole manifest, along with
+// its Tags, is deleted. Otherwise only the Tags are deleted.
+type ContainerRegistryRetentionDeletion struct {
+	Digest string
+	Tags   []string
+}
+
+func ResourceDigitalOceanContainerRegistryRetention() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDigitalOceanContainerRegistryRetentionCreate,
+		ReadContext:   resourceDigitalOceanContainerRegistryRetentionRead,
+		UpdateContext: resourceDigitalOceanContainerRegistryRetentionUpdate,
+		DeleteContext: resourceDigitalOceanContainerRegistryRetentionDelete,
+		CustomizeDiff: resourceDigitalOceanContainerRegistryRetentionCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"registry_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				Description:  "The name of the container registry",
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository_pattern": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ".*",
+							ValidateFunc: validation.StringIsValidRegExp,
+							Description:  "A regular expression matching the names of the repositories the rule applies to",
+						},
+						"tag_pattern": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ".*",
+							ValidateFunc: validation.StringIsValidRegExp,
+							Description:  "A regular expression matching the tags the rule applies to",
+						},
+						"keep_last": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of most recently updated matching manifests to keep in each repository",
+						},
+						"older_than_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Only delete matching manifests that were last updated more than this many days ago",
+						},
+						"include_untagged": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the rule also applies to manifests without any tags",
+						},
+					},
+				},
+			},
+			"garbage_collection_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(godo.GCTypeUntaggedManifestsAndUnreferencedBlobs),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(godo.GCTypeUntaggedManifestsOnly),
+					string(godo.GCTypeUnreferencedBlobsOnly),
+					string(godo.GCTypeUntaggedManifestsAndUnreferencedBlobs),
+				}, false),
+				Description: "The type of garbage collection started once the matching tags and manifests are deleted",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that cause the retention rules to be applied again when changed",
+			},
+			"deleted_tags": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The tags deleted when the retention rules were last applied",
+			},
+			"deleted_manifests": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The manifests deleted when the retention rules were last applied",
+			},
+			"garbage_collection_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UUID of the garbage collection started when the retention rules were last applied",
+			},
+			"blobs_deleted": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of blobs deleted by the garbage collection",
+			},
+			"freed_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of bytes freed by the garbage collection",
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceDigitalOceanContainerRegistryRetentionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i := range d.Get("rule").([]interface{}) {
+		keepLastKey := fmt.Sprintf("rule.%d.keep_last", i)
+		olderThanDaysKey := fmt.Sprintf("rule.%d.older_than_days", i)
+		if !d.NewValueKnown(keepLastKey) || !d.NewValueKnown(olderThanDaysKey) {
+			continue
+		}
+
+		if d.Get(keepLastKey).(int) == 0 && d.Get(olderThanDaysKey).(int) == 0 {
+			return fmt.Errorf("rule %d must set at least one of keep_last or older_than_days", i)
+		}
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanContainerRegistryRetentionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := applyContainerRegistryRetention(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(d.Get("registry_name").(string))
+
+	return resourceDigitalOceanContainerRegistryRetentionRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanContainerRegistryRetentionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	_, _, resp, err := getContainerRegistry(client, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[WARN] Container registry (%s) not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("Error retrieving container registry: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDigitalOceanContainerRegistryRetentionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := applyContainerRegistryRetention(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return diags
+	}
+
+	return resourceDigitalOceanContainerRegistryRetentionRead(ctx, d, meta)
+}
+
+func resourceDigitalOceanContainerRegistryRetentionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Deleted tags and manifests can not be restored, so removing the
+	// resource only stops the rules from being applied.
+	d.SetId("")
+	return nil
+}
+
+func applyContainerRegistryRetention(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) diag.Diagnostics {
+	client := meta.(*config.CombinedConfig).GodoClient()
+
+	registryName := d.Get("registry_name").(string)
+
+	rules, err := ExpandContainerRegistryRetentionRules(d.Get("rule").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	repositories, err := listContainerRegistryRepositories(client, registryName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deletedTags := make([]string, 0)
+	deletedManifests := make([]string, 0)
+	now := time.Now()
+
+	for _, repository := range repositories {
+		var repositoryRules []ContainerRegistryRetentionRule
+		for _, rule := range rules {
+			if rule.repository.MatchString(repository.Name) {
+				repositoryRules = append(repositoryRules, rule)
+			}
+		}
+		if len(repositoryRules) == 0 {
+			continue
+		}
+
+		manifests, err := listContainerRegistryRepositoryManifests(client, registryName, repository.Name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, deletion := range PlanContainerRegistryRetention(repositoryRules, manifests, now) {
+			if deletion.Digest == "" {
+				for _, tag := range deletion.Tags {
+					log.Printf("[INFO] Deleting container registry tag: %s/%s:%s", registryName, repository.Name, tag)
+					resp, err := client.Registry.DeleteTag(context.Background(), registryName, repository.Name, tag)
+					if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+						return diag.Errorf("Error deleting tag %s of repository (%s/%s): %s", tag, registryName, repository.Name, err)
+					}
+					deletedTags = append(deletedTags, fmt.Sprintf("%s:%s", repository.Name, tag))
+				}
+				continue
+			}
+
+			log.Printf("[INFO] Deleting container registry manifest: %s/%s@%s", registryName, repository.Name, deletion.Digest)
+			resp, err := client.Registry.DeleteManifest(context.Background(), registryName, repository.Name, deletion.Digest)
+			if err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+				return diag.Errorf("Error deleting manifest %s of repository (%s/%s): %s", deletion.Digest, registryName, repository.Name, err)
+			}
+			for _, tag := range deletion.Tags {
+				deletedTags = append(deletedTags, fmt.Sprintf("%s:%s", repository.Name, tag))
+			}
+			deletedManifests = append(deletedManifests, fmt.Sprintf("%s@%s", repository.Name, deletion.Digest))
+		}
+	}
+
+	d.Set("deleted_tags", deletedTags)
+	d.Set("deleted_manifests", deletedManifests)
+
+	// There is nothing for a garbage collection to free, and the registry
+	// would needlessly be read-only while it runs.
+	if len(deletedTags) == 0 && len(deletedManifests) == 0 {
+		log.Printf("[INFO] No tags or manifests of container registry %s were deleted, skipping garbage collection", registryName)
+		d.Set("garbage_collection_uuid", "")
+		d.Set("blobs_deleted", 0)
+		d.Set("freed_bytes", 0)
+		return nil
+	}
+
+	gcRequest := &godo.StartGarbageCollectionRequest{
+		Type: godo.GarbageCollectionType(d.Get("garbage_collection_type").(string)),
+	}
+
+	log.Printf("[INFO] Starting garbage collection of container registry: %s", registryName)
+	gc, _, err := client.Registry.StartGarbageCollection(context.Background(), registryName, gcRequest)
+	if err != nil {
+		return diag.Errorf("Error starting garbage collection of container registry (%s): %s", registryName, err)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Delay:      10 * time.Second,
+		Pending:    []string{garbageCollectionStatusRunning},
+		Target:     []string{garbageCollectionStatusSucceeded},
+		Refresh:    garbageCollectionStateRefreshFunc(client, registryName, gc.UUID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for garbage collection (%s) of container registry (%s) to finish: %s", gc.UUID, registryName, err)
+	}
+
+	gc = result.(*godo.GarbageCollection)
+	d.Set("garbage_collection_uuid", gc.UUID)
+	d.Set("blobs_deleted", int(gc.BlobsDeleted))
+	d.Set("freed_bytes", int(gc.FreedBytes))
+
+	return nil
+}
+
+func ExpandContainerRegistryRetentionRules(rawRules []interface{}) ([]ContainerRegistryRetentionRule, error) {
+	rules := make([]ContainerRegistryRetentionRule, 0, len(rawRules))
+	for i, rawRule := range rawRules {
+		r := rawRule.(map[string]interface{})
+
+		repository, err := regexp.Compile(r["repository_pattern"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing repository_pattern of rule %d: %s", i, err)
+		}
+
+		tag, err := regexp.Compile(r["tag_pattern"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing tag_pattern of rule %d: %s", i, err)
+		}
+
+		rules = append(rules, ContainerRegistryRetentionRule{
+			repository:      repository,
+			tag:             tag,
+			keepLast:        r["keep_last"].(int),
+			olderThan:       time.Duration(r["older_than_days"].(int)) * 24 * time.Hour,
+			includeUntagged: r["include_untagged"].(bool),
+		})
+	}
+
+	return rules, nil
+}
+
+// PlanContainerRegistryRetention returns the deletions the rules make from a
+// repository's manifests, applying the rules in order. Tags are removed from
+// the manifests as they are deleted so that later rules see the remaining
+// tags. The given manifests are not modified.
+func PlanContainerRegistryRetention(rules []ContainerRegistryRetentionRule, manifests []*godo.RepositoryManifest, now time.Time) []ContainerRegistryRetentionDeletion {
+	remaining := make([]*godo.RepositoryManifest, 0, len(manifests))
+	for _, manifest := range manifests {
+		m := *manifest
+		remaining = append(remaining, &m)
+	}
+
+	var deletions []ContainerRegistryRetentionDeletion
+	for _, rule := range rules {
+		deleted := make(map[string]bool)
+		for _, manifest := range SelectContainerRegistryRetentionManifests(rule, remaining, now) {
+			var matchedTags, remainingTags []string
+			for _, tag := range manifest.Tags {
+				if rule.tag.MatchString(tag) {
+					matchedTags = append(matchedTags, tag)
+				} else {
+					remainingTags = append(remainingTags, tag)
+				}
+			}
+
+			// Deleting a manifest also deletes its tags, so tags are only
+			// deleted one by one when tags not matched by the rule remain.
+			if len(remainingTags) > 0 {
+				deletions = append(deletions, ContainerRegistryRetentionDeletion{Tags: matchedTags})
+				manifest.Tags = remainingTags
+				continue
+			}
+
+			deletions = append(deletions, ContainerRegistryRetentionDeletion{Digest: manifest.Digest, Tags: matchedTags})
+			deleted[manifest.Digest] = true
+		}
+
+		kept := remaining[:0]
+		for _, manifest := range remaining {
+			if !deleted[manifest.Digest] {
+				kept = append(kept, manifest)
+			}
+		}
+		remaining = kept
+	}
+
+	return deletions
+}
+
+// SelectContainerRegistryRetentionManifests returns the manifests of a
+// repository that the rule deletes tags from. Manifests match when any of
+// their tags match the rule, and the most recently updated matches are kept.
+func SelectContainerRegistryRetentionManifests(rule ContainerRegistryRetentionRule, manifests []*godo.RepositoryManifest, now time.Time) []*godo.RepositoryManifest {
+	var matched []*godo.RepositoryManifest
+	for _, manifest := range manifests {
+		if len(manifest.Tags) == 0 {
+			if rule.includeUntagged {
+				matched = append(matched, manifest)
+			}
+			continue
+		}
+
+		for _, tag := range manifest.Tags {
+			if rule.tag.MatchString(tag) {
+				matched = append(matched, manifest)
+				break
+			}
+		}
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].UpdatedAt.After(matched[j].UpdatedAt)
+	})
+
+	var selected []*godo.RepositoryManifest
+	for i, manifest := range matched {
+		if rule.keepLast > 0 && i < rule.keepLast {
+			continue
+		}
+		if rule.olderThan > 0 && now.Sub(manifest.UpdatedAt) < rule.olderThan {
+			continue
+		}
+		selected = append(selected, manifest)
+	}
+
+	return selected
+}
+
+func garbageCollectionStateRefreshFunc(client *godo.Client, registryName string, uuid string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		gcs, err := listContainerRegistryGarbageCollections(client, registryName)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, gc := range gcs {
+			if gc.UUID != uuid {
+				continue
+			}
+
+			switch gc.Status {
+			case garbageCollectionStatusSucceeded:
+				return gc, garbageCollectionStatusSucceeded, nil
+			case garbageCollectionStatusFailed, garbageCollectionStatusCancelled:
+				return nil, "", fmt.Errorf("garbage collection %s", gc.Status)
+			default:
+				return gc, garbageCollectionStatusRunning, nil
+			}
+		}
+
+		return nil, "", fmt.Errorf("garbage collection (%s) not found", uuid)
+	}
+}
@@ -0,0 +1,271 @@
+package registry_test
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/acceptance"
+	"github.com/digitalocean/terraform-provider-digitalocean/digitalocean/registry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDigitalOceanContainerRegistryRetention_Basic(t *testing.T) {
+	regName := acceptance.RandomTestName()
+
+	resourceConfig := fmt.Sprintf(`
+resource "digitalocean_container_registry" "foo" {
+  name                   = "%s"
+  subscription_tier_slug = "basic"
+}
+
+resource "digitalocean_container_registry_retention" "foo" {
+  registry_name = digitalocean_container_registry.foo.name
+
+  rule {
+    tag_pattern     = "^pr-"
+    keep_last       = 5
+    older_than_days = 30
+  }
+
+  rule {
+    include_untagged = true
+    older_than_days  = 7
+  }
+}
+`, regName)
+
+	dataSourceConfig := `
+data "digitalocean_container_registry_garbage_collections" "foobar" {
+  registry_name = digitalocean_container_registry_retention.foo.registry_name
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registry_retention.foo", "registry_name", regName),
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registry_retention.foo", "rule.#", "2"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registry_retention.foo", "deleted_tags.#", "0"),
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registry_retention.foo", "deleted_manifests.#", "0"),
+					// The registry is empty, so there is nothing to garbage collect.
+					resource.TestCheckResourceAttr(
+						"digitalocean_container_registry_retention.foo", "garbage_collection_uuid", ""),
+				),
+			},
+			{
+				Config: resourceConfig + dataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.digitalocean_container_registry_garbage_collections.foobar", "garbage_collections.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDigitalOceanContainerRegistryRetention_RuleWithoutLimit(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "digitalocean_container_registry_retention" "foo" {
+  registry_name = "foo"
+
+  rule {
+    tag_pattern = "^pr-"
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("rule 0 must set at least one of keep_last or older_than_days"),
+			},
+		},
+	})
+}
+
+// testRetentionRule expands a single retention rule, using the defaults of the
+// schema for the arguments that are not given.
+func testRetentionRule(t *testing.T, args map[string]interface{}) registry.ContainerRegistryRetentionRule {
+	raw := map[string]interface{}{
+		"repository_pattern": ".*",
+		"tag_pattern":        ".*",
+		"keep_last":          0,
+		"older_than_days":    0,
+		"include_untagged":   false,
+	}
+	for k, v := range args {
+		raw[k] = v
+	}
+
+	rules, err := registry.ExpandContainerRegistryRetentionRules([]interface{}{raw})
+	if err != nil {
+		t.Fatalf("error expanding rule: %s", err)
+	}
+
+	return rules[0]
+}
+
+// testRetentionManifests returns manifests updated the given number of days
+// before now, with digests named after their position.
+func testRetentionManifests(now time.Time, days []int, tags [][]string) []*godo.RepositoryManifest {
+	manifests := make([]*godo.RepositoryManifest, 0, len(days))
+	for i := range days {
+		manifests = append(manifests, &godo.RepositoryManifest{
+			Digest:    fmt.Sprintf("sha256:%d", i),
+			Tags:      tags[i],
+			UpdatedAt: now.Add(-time.Duration(days[i]) * 24 * time.Hour),
+		})
+	}
+
+	return manifests
+}
+
+func TestSelectContainerRegistryRetentionManifests(t *testing.T) {
+	now := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	manifests := testRetentionManifests(now,
+		[]int{1, 5, 10, 20, 30},
+		[][]string{{"pr-5"}, {"pr-4"}, {"pr-3", "latest"}, {}, {"v1"}},
+	)
+
+	cases := []struct {
+		name     string
+		rule     map[string]interface{}
+		expected []string
+	}{
+		{
+			name:     "keep_last",
+			rule:     map[string]interface{}{"keep_last": 2},
+			expected: []string{"sha256:2", "sha256:4"},
+		},
+		{
+			name:     "older_than_days",
+			rule:     map[string]interface{}{"older_than_days": 7},
+			expected: []string{"sha256:2", "sha256:4"},
+		},
+		{
+			name:     "keep_last and older_than_days",
+			rule:     map[string]interface{}{"keep_last": 3, "older_than_days": 3},
+			expected: []string{"sha256:4"},
+		},
+		{
+			name:     "tag_pattern",
+			rule:     map[string]interface{}{"tag_pattern": "^pr-", "keep_last": 1},
+			expected: []string{"sha256:1", "sha256:2"},
+		},
+		{
+			name:     "include_untagged",
+			rule:     map[string]interface{}{"tag_pattern": "^pr-", "older_than_days": 7, "include_untagged": true},
+			expected: []string{"sha256:2", "sha256:3"},
+		},
+		{
+			name:     "no matches",
+			rule:     map[string]interface{}{"tag_pattern": "^release-", "keep_last": 1},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			selected := registry.SelectContainerRegistryRetentionManifests(testRetentionRule(t, tc.rule), manifests, now)
+
+			var digests []string
+			for _, manifest := range selected {
+				digests = append(digests, manifest.Digest)
+			}
+			if !reflect.DeepEqual(digests, tc.expected) {
+				t.Errorf("selected %v, expected %v", digests, tc.expected)
+			}
+		})
+	}
+}
+
+func TestPlanContainerRegistryRetention(t *testing.T) {
+	now := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name      string
+		rules     []map[string]interface{}
+		days      []int
+		tags      [][]string
+		expected  []registry.ContainerRegistryRetentionDeletion
+		untouched [][]string
+	}{
+		{
+			name:  "manifests with only matching tags are deleted",
+			rules: []map[string]interface{}{{"tag_pattern": "^pr-", "keep_last": 1}},
+			days:  []int{1, 2, 3},
+			tags:  [][]string{{"pr-3"}, {"pr-2"}, {"pr-1", "pr-1-rebuilt"}},
+			expected: []registry.ContainerRegistryRetentionDeletion{
+				{Digest: "sha256:1", Tags: []string{"pr-2"}},
+				{Digest: "sha256:2", Tags: []string{"pr-1", "pr-1-rebuilt"}},
+			},
+		},
+		{
+			name:  "only matching tags are deleted from manifests with mixed tags",
+			rules: []map[string]interface{}{{"tag_pattern": "^pr-", "older_than_days": 7}},
+			days:  []int{10, 20},
+			tags:  [][]string{{"pr-2", "v2"}, {"pr-1"}},
+			expected: []registry.ContainerRegistryRetentionDeletion{
+				{Tags: []string{"pr-2"}},
+				{Digest: "sha256:1", Tags: []string{"pr-1"}},
+			},
+		},
+		{
+			name:     "untagged manifests are deleted when included",
+			rules:    []map[string]interface{}{{"tag_pattern": "^pr-", "older_than_days": 7, "include_untagged": true}},
+			days:     []int{10, 20},
+			tags:     [][]string{{}, {"v1"}},
+			expected: []registry.ContainerRegistryRetentionDeletion{{Digest: "sha256:0"}},
+		},
+		{
+			name: "later rules see the remaining tags and manifests",
+			rules: []map[string]interface{}{
+				{"tag_pattern": "^pr-", "keep_last": 1},
+				{"keep_last": 1},
+			},
+			days: []int{1, 2, 3, 4},
+			tags: [][]string{{"pr-2"}, {"pr-1", "v2"}, {"v1"}, {"pr-0"}},
+			expected: []registry.ContainerRegistryRetentionDeletion{
+				{Tags: []string{"pr-1"}},
+				{Digest: "sha256:3", Tags: []string{"pr-0"}},
+				{Digest: "sha256:1", Tags: []string{"v2"}},
+				{Digest: "sha256:2", Tags: []string{"v1"}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := make([]registry.ContainerRegistryRetentionRule, 0, len(tc.rules))
+			for _, rule := range tc.rules {
+				rules = append(rules, testRetentionRule(t, rule))
+			}
+			manifests := testRetentionManifests(now, tc.days, tc.tags)
+
+			deletions := registry.PlanContainerRegistryRetention(rules, manifests, now)
+			if !reflect.DeepEqual(deletions, tc.expected) {
+				t.Errorf("planned %+v, expected %+v", deletions, tc.expected)
+			}
+
+			for i, manifest := range manifests {
+				if !reflect.DeepEqual(manifest.Tags, tc.tags[i]) {
+					t.Errorf("tags of manifest %s were modified: %v", manifest.Digest, manifest.Tags)
+				}
+			}
+		})
+	}
+}
@@ -108,6 +108,18 @@ func ResourceDigitalOceanVolume() *schema.Resource {
 			},
 
 			"tags": tag.TagsSchema(),
+
+			"price_monthly": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The estimated monthly price of the volume in USD, based on a hardcoded list price",
+			},
+
+			"price_hourly": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The estimated hourly price of the volume in USD, based on a hardcoded list price",
+			},
 		},
 
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
@@ -119,11 +131,32 @@ func ResourceDigitalOceanVolume() *schema.Resource {
 				return fmt.Errorf("volumes `size` can only be expanded and not shrunk")
 			}
 
-			return nil
+			return customizeDiffVolumePrice(ctx, diff, v)
 		},
 	}
 }
 
+// customizeDiffVolumePrice shows the price of the volume in the plan when its
+// size changes.
+func customizeDiffVolumePrice(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChange("size") {
+		return nil
+	}
+
+	if !d.NewValueKnown("size") {
+		d.SetNewComputed("price_monthly")
+		d.SetNewComputed("price_hourly")
+		return nil
+	}
+
+	price := meta.(*config.CombinedConfig).PriceCatalog().Volume(d.Get("size").(int))
+	if err := d.SetNew("price_monthly", price.Monthly); err != nil {
+		return err
+	}
+
+	return d.SetNew("price_hourly", price.Hourly)
+}
+
 func resourceDigitalOceanVolumeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*config.CombinedConfig).GodoClient()
 
@@ -215,6 +248,10 @@ func resourceDigitalOceanVolumeRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("region", volume.Region.Slug)
 	d.Set("size", int(volume.SizeGigaBytes))
 	d.Set("urn", volume.URN())
+
+	price := meta.(*config.CombinedConfig).PriceCatalog().Volume(int(volume.SizeGigaBytes))
+	d.Set("price_monthly", price.Monthly)
+	d.Set("price_hourly", price.Hourly)
 	d.Set("tags", tag.FlattenTags(volume.Tags))
 
 	if v := volume.Description; v != "" {